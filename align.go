@@ -0,0 +1,201 @@
+package rich
+
+import "strings"
+
+// Align specifies how content is positioned horizontally within a width.
+type Align int
+
+const (
+	// AlignLeft places content at the left edge, padding on the right.
+	// This is the default.
+	AlignLeft Align = iota
+
+	// AlignCenter centers content, splitting the padding between both sides.
+	// When the padding is odd, the extra space goes on the right.
+	AlignCenter
+
+	// AlignRight places content at the right edge, padding on the left.
+	AlignRight
+)
+
+// alignPadding returns the number of spaces to place before and after content
+// of the given display width so that it is aligned within width.
+// Content that is already at least width wide gets no padding.
+func alignPadding(contentWidth, width int, align Align) (left, right int) {
+	padding := width - contentWidth
+	if padding <= 0 {
+		return 0, 0
+	}
+
+	switch align {
+	case AlignCenter:
+		left = padding / 2
+		return left, padding - left
+	case AlignRight:
+		return padding, 0
+	default:
+		return 0, padding
+	}
+}
+
+// justify spreads the words of text across width by widening the gaps
+// between them. Extra spaces go to the leftmost gaps first.
+// Text with a single word, or that doesn't fit, is returned left-aligned.
+func justify(text string, width int) string {
+	words := strings.Fields(text)
+
+	textWidth := 0
+	for _, word := range words {
+		textWidth += DisplayWidth(word)
+	}
+
+	gaps := len(words) - 1
+	if gaps < 1 || textWidth+gaps > width {
+		left, right := alignPadding(DisplayWidth(text), width, AlignLeft)
+		return strings.Repeat(" ", left) + text + strings.Repeat(" ", right)
+	}
+
+	spaces := width - textWidth
+	var b strings.Builder
+	for i, word := range words {
+		b.WriteString(word)
+		if i < gaps {
+			n := spaces / gaps
+			if i < spaces%gaps {
+				n++
+			}
+			b.WriteString(strings.Repeat(" ", n))
+		}
+	}
+	return b.String()
+}
+
+// VerticalAlign specifies how content is positioned vertically within a height.
+type VerticalAlign int
+
+const (
+	// VerticalAlignTop places content at the top, padding below.
+	// This is the default.
+	VerticalAlignTop VerticalAlign = iota
+
+	// VerticalAlignMiddle centers content, splitting the padding above and
+	// below. When the padding is odd, the extra line goes below.
+	VerticalAlignMiddle
+
+	// VerticalAlignBottom places content at the bottom, padding above.
+	VerticalAlignBottom
+)
+
+// Aligned is a renderable that positions another renderable within the
+// available space. The child is measured, rendered at its natural width, and
+// each of its lines is padded so the block as a whole sits at the left,
+// center, or right. With a height set, blank lines position it vertically too.
+//
+// Create one with NewAlign.
+type Aligned struct {
+	child    Renderable    // The renderable being positioned
+	align    Align         // Horizontal position of the block
+	vertical VerticalAlign // Vertical position of the block (needs a height)
+	height   int           // Total height in lines (0 = the child's own height)
+}
+
+// NewAlign wraps child so it is positioned within the render width.
+// The child's width is taken from its Measure method if it implements
+// Measurable, and otherwise from its widest rendered line (see
+// MeasureRenderable). Vertical alignment only has an effect once a height
+// is set with Height.
+//
+// Aligned composes with Group and Columns: it always produces a rectangular
+// block that is exactly the render width wide.
+//
+// Example:
+//
+//	// Center a fixed-width panel in the terminal
+//	p := panel.New("Deployment complete").Width(30)
+//	console.Renderln(rich.NewAlign(p, rich.AlignCenter, rich.VerticalAlignTop))
+func NewAlign(child Renderable, align Align, vertical VerticalAlign) *Aligned {
+	return &Aligned{
+		child:    child,
+		align:    align,
+		vertical: vertical,
+	}
+}
+
+// Height sets the total height of the aligned block in lines.
+// When the child is shorter, blank lines are added above and/or below it
+// according to the vertical alignment. A child taller than the height is
+// not cropped. Default is 0 (no vertical padding).
+//
+// Example:
+//
+//	rich.NewAlign(logo, rich.AlignCenter, rich.VerticalAlignMiddle).Height(console.Height())
+func (a *Aligned) Height(height int) *Aligned {
+	a.height = height
+	return a
+}
+
+// Render implements Renderable.
+func (a *Aligned) Render(console *Console, width int) Segments {
+	if a.child == nil || width <= 0 {
+		return nil
+	}
+
+	childWidth := MeasureRenderable(console, a.child, width).Maximum
+	if childWidth <= 0 || childWidth > width {
+		childWidth = width
+	}
+	left, right := alignPadding(childWidth, width, a.align)
+
+	var lines []Segments
+	for _, line := range blockLines(a.child.Render(console, childWidth)) {
+		var aligned Segments
+		if left > 0 {
+			aligned = append(aligned, Segment{Text: strings.Repeat(" ", left)})
+		}
+		aligned = append(aligned, fitLine(line, childWidth)...)
+		if right > 0 {
+			aligned = append(aligned, Segment{Text: strings.Repeat(" ", right)})
+		}
+		lines = append(lines, aligned)
+	}
+
+	// Vertical padding, using the same split as horizontal alignment
+	if extra := a.height - len(lines); extra > 0 {
+		var above int
+		switch a.vertical {
+		case VerticalAlignMiddle:
+			above = extra / 2
+		case VerticalAlignBottom:
+			above = extra
+		}
+		blank := Segments{{Text: strings.Repeat(" ", width)}}
+
+		padded := make([]Segments, 0, a.height)
+		for i := 0; i < above; i++ {
+			padded = append(padded, blank)
+		}
+		padded = append(padded, lines...)
+		for len(padded) < a.height {
+			padded = append(padded, blank)
+		}
+		lines = padded
+	}
+
+	var result Segments
+	for i, line := range lines {
+		if i > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+		result = append(result, line...)
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// An aligned block needs the same width as its child.
+func (a *Aligned) Measure(console *Console, maxWidth int) Measurement {
+	if a.child == nil {
+		return Measurement{}
+	}
+	return MeasureRenderable(console, a.child, maxWidth)
+}
@@ -0,0 +1,55 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAligned(t *testing.T) {
+	console := NewConsole(nil)
+	child := NewRenderableString("ab\nabcd", NewStyle())
+
+	tests := []struct {
+		align Align
+		want  []string
+	}{
+		{AlignLeft, []string{"ab        ", "abcd      "}},
+		{AlignCenter, []string{"   ab     ", "   abcd   "}},
+		{AlignRight, []string{"      ab  ", "      abcd"}},
+	}
+
+	for _, tt := range tests {
+		got := strings.Split(NewAlign(child, tt.align, VerticalAlignTop).Render(console, 10).String(), "\n")
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("Align %d: got %q, want %q", tt.align, got, tt.want)
+		}
+	}
+}
+
+func TestAlignedVertical(t *testing.T) {
+	console := NewConsole(nil)
+	child := NewRenderableString("x", NewStyle())
+
+	tests := []struct {
+		vertical VerticalAlign
+		want     []string
+	}{
+		{VerticalAlignTop, []string{"x  ", "   ", "   ", "   "}},
+		{VerticalAlignMiddle, []string{"   ", " x ", "   ", "   "}},
+		{VerticalAlignBottom, []string{"   ", "   ", "   ", "  x"}},
+	}
+
+	aligns := map[VerticalAlign]Align{
+		VerticalAlignTop:    AlignLeft,
+		VerticalAlignMiddle: AlignCenter,
+		VerticalAlignBottom: AlignRight,
+	}
+
+	for _, tt := range tests {
+		aligned := NewAlign(child, aligns[tt.vertical], tt.vertical).Height(4)
+		got := strings.Split(aligned.Render(console, 3).String(), "\n")
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("VerticalAlign %d: got %q, want %q", tt.vertical, got, tt.want)
+		}
+	}
+}
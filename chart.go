@@ -0,0 +1,222 @@
+package rich
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sparkBlocks are the eighth-height block characters used by Sparkline,
+// from lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// partialBlocks are the left-aligned eighth-width block characters used for
+// the fractional end of a bar, from one eighth to seven eighths.
+var partialBlocks = []string{"▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// Sparkline returns a compact one-line chart of values using the eighth-block
+// characters ▁▂▃▄▅▆▇█. Values are scaled between the smallest (▁) and the
+// largest (█); if all values are equal, every block is ▁. Non-finite values
+// (NaN and ±Inf) are left out of the scale and shown as spaces. Returns an
+// empty string for no values.
+//
+// Example:
+//
+//	latencies := []float64{12, 15, 11, 30, 45, 22, 18}
+//	console.Println("latency", rich.Sparkline(latencies)) // latency ▁▂▁▅█▃▂
+func Sparkline(values []float64) string {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if isFinite(v) {
+			low = math.Min(low, v)
+			high = math.Max(high, v)
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case !isFinite(v):
+			b.WriteRune(' ')
+		case high == low:
+			b.WriteRune(sparkBlocks[0])
+		default:
+			level := int(math.Round((v - low) / (high - low) * float64(len(sparkBlocks)-1)))
+			b.WriteRune(sparkBlocks[min(max(level, 0), len(sparkBlocks)-1)])
+		}
+	}
+	return b.String()
+}
+
+// BarChart is a renderable that draws labeled values as horizontal bars.
+// Bars are scaled so the largest value fills the available width, with
+// eighth-width block characters for smooth lengths. Labels are aligned in a
+// column on the left, and values can optionally be shown on the right.
+//
+// Create one with NewBarChart. See Sparkline for a one-line alternative.
+type BarChart struct {
+	items      []barChartItem // Bars in display order
+	showValues bool           // Whether to print each value after its bar
+	barStyle   Style          // Style for the bars
+	labelStyle Style          // Style for the labels
+}
+
+// barChartItem is a single labeled value in a BarChart.
+type barChartItem struct {
+	label string
+	value float64
+}
+
+// NewBarChart creates an empty bar chart with values shown and cyan bars.
+//
+// Example:
+//
+//	chart := rich.NewBarChart().
+//		Add("web-1", 72.5).
+//		Add("web-2", 31).
+//		Add("db-1", 90.2)
+//	console.Renderln(chart)
+//
+// Output at 40 columns:
+//
+//	web-1 ███████████████████████▎      72.5
+//	web-2 ██████████                    31
+//	db-1  █████████████████████████████ 90.2
+func NewBarChart() *BarChart {
+	return &BarChart{
+		showValues: true,
+		barStyle:   NewStyle().Foreground(Cyan),
+		labelStyle: NewStyle(),
+	}
+}
+
+// Add appends a labeled value to the chart.
+// Negative and non-finite (NaN, ±Inf) values are drawn as empty bars, and
+// are left out when scaling the other bars.
+//
+// Example:
+//
+//	chart.Add("cpu", 42)
+func (c *BarChart) Add(label string, value float64) *BarChart {
+	c.items = append(c.items, barChartItem{label: label, value: value})
+	return c
+}
+
+// ShowValues controls whether each value is printed after its bar.
+// Default is true.
+//
+// Example:
+//
+//	rich.NewBarChart().ShowValues(false)
+func (c *BarChart) ShowValues(show bool) *BarChart {
+	c.showValues = show
+	return c
+}
+
+// BarStyle sets the style for the bars.
+// Default is a cyan foreground.
+//
+// Example:
+//
+//	chart.BarStyle(rich.NewStyle().Foreground(rich.Green))
+func (c *BarChart) BarStyle(style Style) *BarChart {
+	c.barStyle = style
+	return c
+}
+
+// LabelStyle sets the style for the labels.
+// Default is unstyled.
+//
+// Example:
+//
+//	chart.LabelStyle(rich.NewStyle().Bold())
+func (c *BarChart) LabelStyle(style Style) *BarChart {
+	c.labelStyle = style
+	return c
+}
+
+// Render implements Renderable.
+// Each line has the form "label bar value", with the bar area taking all the
+// width not needed by the label and value columns.
+func (c *BarChart) Render(console *Console, width int) Segments {
+	if len(c.items) == 0 {
+		return nil
+	}
+
+	labelWidth, valueWidth, maxValue := 0, 0, 0.0
+	values := make([]string, len(c.items))
+	for i, item := range c.items {
+		labelWidth = max(labelWidth, DisplayWidth(item.label))
+		values[i] = strconv.FormatFloat(item.value, 'f', -1, 64)
+		valueWidth = max(valueWidth, len(values[i]))
+		if isFinite(item.value) {
+			maxValue = math.Max(maxValue, item.value)
+		}
+	}
+
+	barWidth := width - labelWidth - 1
+	if c.showValues {
+		barWidth -= valueWidth + 1
+	}
+	barWidth = max(barWidth, 1)
+
+	var result Segments
+	for i, item := range c.items {
+		if i > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+
+		label := item.label + strings.Repeat(" ", labelWidth-DisplayWidth(item.label)) + " "
+		result = append(result, Segment{Text: label, Style: c.labelStyle})
+
+		bar := ""
+		if maxValue > 0 && item.value > 0 && isFinite(item.value) {
+			bar = barBlocks(item.value / maxValue * float64(barWidth))
+		}
+		result = append(result, Segment{Text: bar, Style: c.barStyle})
+
+		if c.showValues {
+			padding := barWidth - DisplayWidth(bar) + 1
+			result = append(result, Segment{Text: strings.Repeat(" ", padding) + values[i]})
+		}
+	}
+
+	return result
+}
+
+// Measure implements Measurable.
+// The chart needs room for the labels, values, and at least one bar column,
+// and has no natural maximum: bars grow to fill the width.
+func (c *BarChart) Measure(console *Console, maxWidth int) Measurement {
+	labelWidth, valueWidth := 0, 0
+	for _, item := range c.items {
+		labelWidth = max(labelWidth, DisplayWidth(item.label))
+		valueWidth = max(valueWidth, len(strconv.FormatFloat(item.value, 'f', -1, 64)))
+	}
+
+	minimum := labelWidth + 2
+	if c.showValues {
+		minimum += valueWidth + 1
+	}
+	return Measurement{Minimum: minimum, Maximum: max(minimum, maxWidth)}
+}
+
+// barBlocks returns a bar length columns long, using full blocks and an
+// eighth-width block for the fractional remainder. Negative and non-finite
+// lengths give an empty bar.
+func barBlocks(length float64) string {
+	if !isFinite(length) || length <= 0 {
+		return ""
+	}
+	eighths := int(math.Round(length * 8))
+	bar := strings.Repeat("█", eighths/8)
+	if rem := eighths % 8; rem > 0 {
+		bar += partialBlocks[rem-1]
+	}
+	return bar
+}
+
+// isFinite reports whether v is neither NaN nor ±Inf.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
@@ -0,0 +1,99 @@
+package rich
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   string
+	}{
+		{[]float64{0, 1, 2, 3, 4, 5, 6, 7}, "▁▂▃▄▅▆▇█"},
+		{[]float64{10, 20}, "▁█"},
+		{[]float64{0, 50, 100}, "▁▅█"},
+		{[]float64{3, 3, 3}, "▁▁▁"},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Sparkline(tt.values); got != tt.want {
+			t.Errorf("Sparkline(%v) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestBarChart(t *testing.T) {
+	console := NewConsole(nil)
+
+	chart := NewBarChart().
+		Add("a", 10).
+		Add("bbb", 5).
+		Add("cc", 2.5)
+
+	lines := strings.Split(chart.Render(console, 20).String(), "\n")
+
+	// Label column is 3 wide, values column 3 wide: 20-4-4 = 12 bar columns
+	want := []string{
+		"a   ████████████ 10",
+		"bbb ██████       5",
+		"cc  ███          2.5",
+	}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("BarChart output = %q, want %q", lines, want)
+	}
+
+	// The longest value fills the whole bar area
+	if n := strings.Count(lines[0], "█"); n != 12 {
+		t.Errorf("Expected full-width bar of 12 blocks, got %d", n)
+	}
+}
+
+func TestBarChartPartialBlocks(t *testing.T) {
+	console := NewConsole(nil)
+
+	chart := NewBarChart().ShowValues(false).
+		Add("x", 8).
+		Add("y", 3)
+
+	lines := strings.Split(chart.Render(console, 10).String(), "\n")
+	if lines[0] != "x ████████" {
+		t.Errorf("line 0 = %q, want %q", lines[0], "x ████████")
+	}
+	if lines[1] != "y ███" {
+		t.Errorf("line 1 = %q, want %q", lines[1], "y ███")
+	}
+
+	if got := barBlocks(2.5); got != "██▌" {
+		t.Errorf("barBlocks(2.5) = %q, want %q", got, "██▌")
+	}
+}
+
+func TestChartsNonFinite(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+
+	// Non-finite values are blank and don't affect the scale of the rest
+	if got := Sparkline([]float64{1, 2, inf, nan, math.Inf(-1)}); got != "▁█   " {
+		t.Errorf("Sparkline with Inf and NaN = %q, want %q", got, "▁█   ")
+	}
+	if got := Sparkline([]float64{inf, nan}); got != "  " {
+		t.Errorf("Sparkline of only non-finite values = %q, want %q", got, "  ")
+	}
+
+	chart := NewBarChart().ShowValues(false).
+		Add("a", 4).
+		Add("b", inf).
+		Add("c", nan)
+	lines := strings.Split(chart.Render(NewConsole(nil), 10).String(), "\n")
+	if lines[0] != "a ████████" || lines[1] != "b " || lines[2] != "c " {
+		t.Errorf("BarChart with Inf and NaN = %q", lines)
+	}
+
+	for _, length := range []float64{inf, math.Inf(-1), nan, -3} {
+		if got := barBlocks(length); got != "" {
+			t.Errorf("barBlocks(%v) = %q, want empty", length, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package rich
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -322,8 +323,14 @@ func RGB(r, g, b uint8) RGBColor {
 }
 
 // Hex creates an RGBColor from a hexadecimal color string.
-// Accepts formats with or without the leading "#": "#FF0000" or "FF0000".
-// The string must be exactly 6 hexadecimal digits (RRGGBB format).
+// Accepts formats with or without the leading "#", in any of the forms used
+// by CSS and design tools:
+//   - RRGGBB: "#FF0000"
+//   - RGB shorthand, each digit doubled: "#F00" is "#FF0000"
+//   - RRGGBBAA and RGBA, with an alpha channel: "#FF000080", "#F008"
+//
+// Terminals have no transparency, so the alpha channel is ignored and the
+// color is returned fully opaque. Use HexOver to blend it over a background.
 //
 // Returns an error if the string format is invalid or contains non-hex characters.
 //
@@ -331,35 +338,70 @@ func RGB(r, g, b uint8) RGBColor {
 //
 //	red, _ := rich.Hex("#FF0000")
 //	blue, _ := rich.Hex("0000FF")
-//	invalid, err := rich.Hex("#FFF") // Error: invalid length
+//	white, _ := rich.Hex("#FFF")
+//	invalid, err := rich.Hex("#FFFFF") // Error: invalid length
 func Hex(hex string) (RGBColor, error) {
-	// Remove optional leading "#"
-	hex = strings.TrimPrefix(hex, "#")
+	color, _, err := parseHex(hex)
+	return color, err
+}
 
-	// Validate length (must be exactly 6 characters for RRGGBB)
-	if len(hex) != 6 {
-		return RGBColor{}, fmt.Errorf("invalid hex color: %s", hex)
+// HexOver is like Hex, but blends a color with an alpha channel over
+// background, the way it would look on screen with that background behind
+// it. Colors without an alpha channel are returned unchanged.
+//
+// Example:
+//
+//	// 50% red over a dark terminal background
+//	c, _ := rich.HexOver("#FF000080", rich.RGB(30, 30, 30)) // #8F0F0F
+func HexOver(hex string, background RGBColor) (RGBColor, error) {
+	color, alpha, err := parseHex(hex)
+	if err != nil {
+		return RGBColor{}, err
 	}
 
-	// Parse red component (first 2 hex digits)
-	r, err := strconv.ParseUint(hex[0:2], 16, 8)
-	if err != nil {
-		return RGBColor{}, fmt.Errorf("invalid hex color: %s", hex)
+	blend := func(fg, bg uint8) uint8 {
+		return uint8((int(fg)*int(alpha) + int(bg)*(255-int(alpha)) + 127) / 255)
 	}
+	return RGBColor{
+		R: blend(color.R, background.R),
+		G: blend(color.G, background.G),
+		B: blend(color.B, background.B),
+	}, nil
+}
 
-	// Parse green component (middle 2 hex digits)
-	g, err := strconv.ParseUint(hex[2:4], 16, 8)
-	if err != nil {
-		return RGBColor{}, fmt.Errorf("invalid hex color: %s", hex)
+// parseHex parses a hex color string in any form accepted by Hex, returning
+// the color and its alpha channel (255 when the string has none).
+func parseHex(hex string) (color RGBColor, alpha uint8, err error) {
+	// Remove optional leading "#"
+	digits := strings.TrimPrefix(hex, "#")
+
+	// Expand shorthand forms by doubling each digit
+	if len(digits) == 3 || len(digits) == 4 {
+		var b strings.Builder
+		for i := 0; i < len(digits); i++ {
+			b.WriteByte(digits[i])
+			b.WriteByte(digits[i])
+		}
+		digits = b.String()
 	}
 
-	// Parse blue component (last 2 hex digits)
-	b, err := strconv.ParseUint(hex[4:6], 16, 8)
-	if err != nil {
-		return RGBColor{}, fmt.Errorf("invalid hex color: %s", hex)
+	// Validate length (RRGGBB, or RRGGBBAA with alpha)
+	if len(digits) != 6 && len(digits) != 8 {
+		return RGBColor{}, 0, fmt.Errorf("invalid hex color: %s", hex)
+	}
+
+	// Parse each component from its pair of hex digits
+	var components [4]uint8
+	components[3] = 255
+	for i := 0; i < len(digits)/2; i++ {
+		v, err := strconv.ParseUint(digits[2*i:2*i+2], 16, 8)
+		if err != nil {
+			return RGBColor{}, 0, fmt.Errorf("invalid hex color: %s", hex)
+		}
+		components[i] = uint8(v)
 	}
 
-	return RGBColor{R: uint8(r), G: uint8(g), B: uint8(b)}, nil
+	return RGBColor{R: components[0], G: components[1], B: components[2]}, components[3], nil
 }
 
 // namedColors maps color names to RGB values.
@@ -408,7 +450,7 @@ func Named(name string) (RGBColor, error) {
 
 // ColorRGB extracts the RGB components from any Color value.
 // Returns (r, g, b, true) for RGBColor and ANSI256Color (approximated),
-// (r, g, b, false) for ANSIColor (returns representative values), and
+// (r, g, b, false) for ANSIColor (returns palette values; see ToRGB), and
 // (0, 0, 0, false) for nil or unrecognised types.
 func ColorRGB(c Color) (r, g, b uint8, ok bool) {
 	if c == nil {
@@ -427,6 +469,63 @@ func ColorRGB(c Color) (r, g, b uint8, ok bool) {
 	return 0, 0, 0, false
 }
 
+// ColorEqual reports whether two colors are the same.
+// Colors are equal when they have the same type and value: Red (ANSIColor),
+// ANSI256Color(1) and RGB(255, 0, 0) are all different colors, even though
+// they may look alike on screen. Two nil colors are equal.
+//
+// Color is an interface, so this is the way to compare colors held in
+// Color variables, such as those returned by Style.FgColor.
+//
+// Example:
+//
+//	rich.ColorEqual(rich.Red, rich.Red)            // true
+//	rich.ColorEqual(rich.RGB(0, 0, 0), rich.Black) // false
+//	rich.ColorEqual(nil, nil)                      // true
+func ColorEqual(a, b Color) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	switch x := a.(type) {
+	case ANSIColor:
+		y, ok := b.(ANSIColor)
+		return ok && x == y
+	case ANSI256Color:
+		y, ok := b.(ANSI256Color)
+		return ok && x == y
+	case RGBColor:
+		y, ok := b.(RGBColor)
+		return ok && x == y
+	}
+	return false
+}
+
+// ansiColorNames holds the markup names of the 16 standard colors, by index.
+var ansiColorNames = [...]string{
+	"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white",
+	"bright_black", "bright_red", "bright_green", "bright_yellow",
+	"bright_blue", "bright_magenta", "bright_cyan", "bright_white",
+}
+
+// String returns the markup name of the color, such as "red" or "bright_blue".
+// Values outside 0-15 are shown as "ansi(n)".
+func (c ANSIColor) String() string {
+	if c >= 0 && int(c) < len(ansiColorNames) {
+		return ansiColorNames[c]
+	}
+	return fmt.Sprintf("ansi(%d)", int(c))
+}
+
+// String returns the color in the form "color(n)".
+func (c ANSI256Color) String() string {
+	return fmt.Sprintf("color(%d)", int(c))
+}
+
+// String returns the color as a lowercase hex string, such as "#ff8800".
+func (c RGBColor) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
 // ANSIIndex returns the ANSI color index (0-15) if c is an ANSIColor,
 // or (0, false) otherwise.
 func ANSIIndex(c Color) (int, bool) {
@@ -447,53 +546,294 @@ func RGBAColor(r, g, b, _ uint8) RGBColor {
 	return RGBColor{R: r, G: g, B: b}
 }
 
-// toRGB returns an approximate RGB representation for ANSI256Color.
+// standardPalette holds the RGB values used for the 16 standard ANSI colors,
+// indexed by ANSIColor. These are the classic VGA colors; the colors actually
+// shown depend on the terminal's theme.
+var standardPalette = [16]RGBColor{
+	{0, 0, 0},       // Black
+	{170, 0, 0},     // Red
+	{0, 170, 0},     // Green
+	{170, 85, 0},    // Yellow/Brown
+	{0, 0, 170},     // Blue
+	{170, 0, 170},   // Magenta
+	{0, 170, 170},   // Cyan
+	{170, 170, 170}, // White
+	{85, 85, 85},    // BrightBlack/Gray
+	{255, 85, 85},   // BrightRed
+	{85, 255, 85},   // BrightGreen
+	{255, 255, 85},  // BrightYellow
+	{85, 85, 255},   // BrightBlue
+	{255, 85, 255},  // BrightMagenta
+	{85, 255, 255},  // BrightCyan
+	{255, 255, 255}, // BrightWhite
+}
+
+// cubeLevels are the component values of the 6×6×6 color cube (indices
+// 16-231) in the xterm 256-color palette.
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// ansi256Palette holds the RGB values of the 256-color palette:
+//   - 0-15: the standard colors (see standardPalette)
+//   - 16-231: the 6×6×6 cube, index = 16 + 36×r + 6×g + b
+//   - 232-255: a grayscale ramp from RGB(8,8,8) to RGB(238,238,238)
+var ansi256Palette = func() [256]RGBColor {
+	var palette [256]RGBColor
+	copy(palette[:16], standardPalette[:])
+	for i := 0; i < 216; i++ {
+		palette[16+i] = RGBColor{
+			R: cubeLevels[i/36],
+			G: cubeLevels[(i/6)%6],
+			B: cubeLevels[i%6],
+		}
+	}
+	for i := 0; i < 24; i++ {
+		g := uint8(8 + i*10)
+		palette[232+i] = RGBColor{R: g, G: g, B: g}
+	}
+	return palette
+}()
+
+// ToRGB resolves any Color to its RGB value.
+// RGBColor is returned unchanged; ANSIColor and ANSI256Color are looked up in
+// the standard 16-color and 256-color palettes. Palette colors are an
+// approximation, since terminals let users change their colors. A nil color
+// resolves to black, and out-of-range indices to mid gray.
+//
+// Example:
+//
+//	rich.ToRGB(rich.BrightRed)         // RGB(255, 85, 85)
+//	rich.ToRGB(rich.ANSI256Color(208)) // RGB(255, 135, 0)
+//	rich.ToRGB(rich.RGB(12, 34, 56))   // RGB(12, 34, 56)
+func ToRGB(c Color) RGBColor {
+	switch v := c.(type) {
+	case RGBColor:
+		return v
+	case ANSI256Color:
+		return v.toRGB()
+	case ANSIColor:
+		return v.toRGB()
+	case nil:
+		return RGBColor{}
+	}
+	return RGBColor{128, 128, 128}
+}
+
+// toRGB returns the 256-color palette entry for the color.
 func (c ANSI256Color) toRGB() RGBColor {
-	idx := int(c)
-	if idx < 16 {
-		// Delegate to ANSIColor
-		return ANSIColor(idx).toRGB()
-	}
-	if idx >= 232 {
-		// Grayscale ramp 232-255
-		g := uint8(8 + (idx-232)*10)
-		return RGBColor{R: g, G: g, B: g}
-	}
-	// 216-color cube: indices 16-231
-	idx -= 16
-	b := uint8((idx % 6) * 51)
-	idx /= 6
-	g := uint8((idx % 6) * 51)
-	idx /= 6
-	r := uint8((idx % 6) * 51)
-	return RGBColor{R: r, G: g, B: b}
+	if int(c) >= 0 && int(c) < len(ansi256Palette) {
+		return ansi256Palette[c]
+	}
+	return RGBColor{128, 128, 128}
 }
 
-// toRGB returns an approximate RGB representation for a standard ANSIColor.
-// These are typical terminal color values; actual rendering depends on the
-// terminal's color theme.
+// toRGB returns the standard palette entry for the color.
 func (c ANSIColor) toRGB() RGBColor {
-	// Standard VGA palette approximation.
-	var table = [16]RGBColor{
-		{0, 0, 0},       // Black
-		{170, 0, 0},     // Red
-		{0, 170, 0},     // Green
-		{170, 85, 0},    // Yellow/Brown
-		{0, 0, 170},     // Blue
-		{170, 0, 170},   // Magenta
-		{0, 170, 170},   // Cyan
-		{170, 170, 170}, // White
-		{85, 85, 85},    // BrightBlack/Gray
-		{255, 85, 85},   // BrightRed
-		{85, 255, 85},   // BrightGreen
-		{255, 255, 85},  // BrightYellow
-		{85, 85, 255},   // BrightBlue
-		{255, 85, 255},  // BrightMagenta
-		{85, 255, 255},  // BrightCyan
-		{255, 255, 255}, // BrightWhite
-	}
-	if int(c) >= 0 && int(c) < 16 {
-		return table[c]
+	if int(c) >= 0 && int(c) < len(standardPalette) {
+		return standardPalette[c]
 	}
 	return RGBColor{128, 128, 128}
 }
+
+// luminanceThreshold is the relative luminance at which black and white text
+// have equal contrast against a background: (1.0+0.05)/(L+0.05) equals
+// (L+0.05)/(0.0+0.05) when L = sqrt(1.05*0.05) - 0.05 ≈ 0.179.
+const luminanceThreshold = 0.179
+
+// luminance returns the WCAG relative luminance of the color, from 0.0
+// (black) to 1.0 (white). Each sRGB component is linearized before weighting:
+//
+//	L = 0.2126*R + 0.7152*G + 0.0722*B
+func (c RGBColor) luminance() float64 {
+	linear := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// ReadableForeground returns black or white, whichever has the higher contrast
+// ratio against the given background color (per WCAG 2.x).
+//
+// Backgrounds with a relative luminance above ~0.179 get black text; darker
+// backgrounds get white text. At that threshold both choices have the same
+// contrast ratio.
+//
+// Example:
+//
+//	bg := rich.RGB(30, 60, 120)
+//	style := rich.NewStyle().Background(bg).Foreground(rich.ReadableForeground(bg)) // white
+func ReadableForeground(bg RGBColor) RGBColor {
+	if bg.luminance() > luminanceThreshold {
+		return RGBColor{R: 0, G: 0, B: 0}
+	}
+	return RGBColor{R: 255, G: 255, B: 255}
+}
+
+// ContrastColor returns black or white, whichever is more legible on the
+// background color bg. It works like ReadableForeground, but accepts any
+// Color: palette colors are resolved with ToRGB first.
+//
+// Example:
+//
+//	rich.ContrastColor(rich.BrightYellow)       // black
+//	rich.ContrastColor(rich.ANSI256Color(17))   // white (dark blue)
+//	rich.ContrastColor(rich.RGB(240, 240, 240)) // black
+func ContrastColor(bg Color) Color {
+	return ReadableForeground(ToRGB(bg))
+}
+
+// Gradient returns steps colors evenly spaced from from to to, inclusive,
+// interpolated in RGB space. Palette colors are resolved with ToRGB first.
+// A single step gives just from; steps below 1 give nil.
+//
+// Example:
+//
+//	colors := rich.Gradient(rich.RGB(255, 0, 0), rich.RGB(0, 0, 255), 8)
+//	for _, c := range colors {
+//		console.PrintStyled(rich.NewStyle().Foreground(c).Render("█"))
+//	}
+func Gradient(from, to Color, steps int) []RGBColor {
+	if steps < 1 {
+		return nil
+	}
+
+	start, end := ToRGB(from), ToRGB(to)
+	lerp := func(a, b uint8, t float64) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+	}
+
+	colors := make([]RGBColor, steps)
+	for i := range colors {
+		t := 0.0
+		if steps > 1 {
+			t = float64(i) / float64(steps-1)
+		}
+		colors[i] = RGBColor{
+			R: lerp(start.R, end.R, t),
+			G: lerp(start.G, end.G, t),
+			B: lerp(start.B, end.B, t),
+		}
+	}
+	return colors
+}
+
+// Darken returns the color with its HSL lightness reduced by the fraction f
+// (0.0-1.0) of its current value: Darken(0.5) halves the lightness, and
+// Darken(1) gives black. Hue and saturation are kept, so a palette derived
+// with Darken stays in the same color family. f is clamped to 0.0-1.0.
+//
+// Example:
+//
+//	base := rich.RGB(66, 135, 245)
+//	pressed := base.Darken(0.2)
+func (c RGBColor) Darken(f float64) RGBColor {
+	h, s, l := c.toHSL()
+	return hslToRGB(h, s, l*(1-clampFraction(f)))
+}
+
+// Lighten returns the color with its HSL lightness moved the fraction f
+// (0.0-1.0) of the way to white: Lighten(0.5) is halfway between the color
+// and white, and Lighten(1) gives white. f is clamped to 0.0-1.0.
+//
+// Example:
+//
+//	base := rich.RGB(66, 135, 245)
+//	hover := base.Lighten(0.2)
+func (c RGBColor) Lighten(f float64) RGBColor {
+	h, s, l := c.toHSL()
+	return hslToRGB(h, s, l+(1-l)*clampFraction(f))
+}
+
+// Saturate returns the color with its HSL saturation moved the fraction f
+// (0.0-1.0) of the way to full saturation. Grays have no hue and are
+// returned unchanged. f is clamped to 0.0-1.0.
+//
+// Example:
+//
+//	vivid := rich.RGB(120, 140, 160).Saturate(0.5)
+func (c RGBColor) Saturate(f float64) RGBColor {
+	h, s, l := c.toHSL()
+	if s == 0 {
+		return c
+	}
+	return hslToRGB(h, s+(1-s)*clampFraction(f), l)
+}
+
+// Desaturate returns the color with its HSL saturation reduced by the
+// fraction f (0.0-1.0) of its current value. Desaturate(1) gives the gray
+// of the same lightness, which is useful for disabled states. f is clamped
+// to 0.0-1.0.
+//
+// Example:
+//
+//	disabled := rich.RGB(66, 135, 245).Desaturate(0.8)
+func (c RGBColor) Desaturate(f float64) RGBColor {
+	h, s, l := c.toHSL()
+	return hslToRGB(h, s*(1-clampFraction(f)), l)
+}
+
+// clampFraction limits f to the range 0.0-1.0.
+func clampFraction(f float64) float64 {
+	return math.Max(0, math.Min(1, f))
+}
+
+// toHSL converts the color to hue (0-360 degrees), saturation (0.0-1.0), and
+// lightness (0.0-1.0).
+func (c RGBColor) toHSL() (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+
+	l = (maxC + minC) / 2
+	delta := maxC - minC
+	if delta == 0 {
+		return 0, 0, l // Gray: no hue or saturation
+	}
+
+	s = delta / (1 - math.Abs(2*l-1))
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/delta, 6)
+	case g:
+		h = (b-r)/delta + 2
+	default:
+		h = (r-g)/delta + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, l
+}
+
+// hslToRGB converts hue (degrees), saturation, and lightness back to an
+// RGBColor, rounding each component to the nearest value.
+func hslToRGB(h, s, l float64) RGBColor {
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g = chroma, x
+	case h < 120:
+		r, g = x, chroma
+	case h < 180:
+		g, b = chroma, x
+	case h < 240:
+		g, b = x, chroma
+	case h < 300:
+		r, b = x, chroma
+	default:
+		r, b = chroma, x
+	}
+
+	component := func(v float64) uint8 {
+		return uint8(math.Round(clampFraction(v+m) * 255))
+	}
+	return RGBColor{R: component(r), G: component(g), B: component(b)}
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+
+package rich
+
+import "os"
+
+// detectPlatformColorMode reports a platform-specific color mode.
+// Unix terminals interpret ANSI escapes natively, so detection is left
+// entirely to the environment checks in detectColorMode.
+func detectPlatformColorMode(f *os.File) (mode ColorMode, ok bool) {
+	return ColorModeNone, false
+}
@@ -1,6 +1,8 @@
 package rich
 
 import (
+	"fmt"
+	"math"
 	"testing"
 )
 
@@ -15,8 +17,15 @@ func TestHex(t *testing.T) {
 		{"#00FF00", RGBColor{0, 255, 0}, false},
 		{"#0000FF", RGBColor{0, 0, 255}, false},
 		{"#FF1493", RGBColor{255, 20, 147}, false},
+		{"#F00", RGBColor{255, 0, 0}, false},
+		{"abc", RGBColor{0xAA, 0xBB, 0xCC}, false},
+		{"#FF149380", RGBColor{255, 20, 147}, false},
+		{"#F008", RGBColor{255, 0, 0}, false},
 		{"#ZZZZZZ", RGBColor{}, true},
 		{"#FF", RGBColor{}, true},
+		{"#FFFFF", RGBColor{}, true},
+		{"#F0G", RGBColor{}, true},
+		{"#FF0000FFF", RGBColor{}, true},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +40,44 @@ func TestHex(t *testing.T) {
 			}
 		})
 	}
+
+	// Errors show the string as passed, not the expanded form
+	for _, input := range []string{"#abz", "#12345", "ff00zz"} {
+		_, err := Hex(input)
+		if err == nil || err.Error() != "invalid hex color: "+input {
+			t.Errorf("Hex(%q) error = %v, want %q", input, err, "invalid hex color: "+input)
+		}
+	}
+}
+
+func TestHexOver(t *testing.T) {
+	background := RGB(30, 30, 30)
+
+	tests := []struct {
+		input    string
+		expected RGBColor
+	}{
+		{"#FF0000", RGBColor{255, 0, 0}},   // No alpha: unchanged
+		{"#FF0000FF", RGBColor{255, 0, 0}}, // Opaque
+		{"#FF000000", background},          // Fully transparent
+		{"#FF000080", RGBColor{143, 15, 15}},
+		{"#FFF8", RGBColor{150, 150, 150}},
+	}
+
+	for _, tt := range tests {
+		got, err := HexOver(tt.input, background)
+		if err != nil {
+			t.Errorf("HexOver(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("HexOver(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	if _, err := HexOver("#GG0000", background); err == nil {
+		t.Error("Expected an error for an invalid hex color")
+	}
 }
 
 func TestNamed(t *testing.T) {
@@ -103,3 +150,212 @@ func TestRGBColor_toANSI(t *testing.T) {
 		})
 	}
 }
+
+func TestReadableForeground(t *testing.T) {
+	black := RGB(0, 0, 0)
+	white := RGB(255, 255, 255)
+
+	tests := []struct {
+		name string
+		bg   RGBColor
+		want RGBColor
+	}{
+		{"black", RGB(0, 0, 0), white},
+		{"navy", RGB(0, 0, 128), white},
+		{"dark red", RGB(139, 0, 0), white},
+		{"mid gray", RGB(128, 128, 128), black},
+		{"yellow", RGB(255, 255, 0), black},
+		{"white", RGB(255, 255, 255), black},
+	}
+
+	for _, tt := range tests {
+		if got := ReadableForeground(tt.bg); got != tt.want {
+			t.Errorf("ReadableForeground(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Endpoints of the luminance scale
+	if l := white.luminance(); l != 1.0 {
+		t.Errorf("Expected white luminance 1.0, got %f", l)
+	}
+	if l := black.luminance(); l != 0.0 {
+		t.Errorf("Expected black luminance 0.0, got %f", l)
+	}
+}
+
+func TestColorEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Color
+		want bool
+	}{
+		{"same ANSI", Red, Red, true},
+		{"different ANSI", Red, Blue, false},
+		{"same 256", ANSI256Color(196), ANSI256Color(196), true},
+		{"different 256", ANSI256Color(196), ANSI256Color(197), false},
+		{"same RGB", RGB(255, 0, 0), RGB(255, 0, 0), true},
+		{"different RGB", RGB(255, 0, 0), RGB(255, 0, 1), false},
+		{"ANSI vs 256 same index", Red, ANSI256Color(1), false},
+		{"ANSI vs RGB", Red, RGB(255, 0, 0), false},
+		{"256 vs RGB", ANSI256Color(16), RGB(0, 0, 0), false},
+		{"both nil", nil, nil, true},
+		{"nil vs color", nil, Black, false},
+		{"color vs nil", Black, nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := ColorEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: ColorEqual(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestColorString(t *testing.T) {
+	tests := []struct {
+		color Color
+		want  string
+	}{
+		{Red, "red"},
+		{BrightBlue, "bright_blue"},
+		{ANSIColor(20), "ansi(20)"},
+		{ANSI256Color(208), "color(208)"},
+		{RGB(255, 136, 0), "#ff8800"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.color); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestToRGB(t *testing.T) {
+	tests := []struct {
+		name  string
+		color Color
+		want  RGBColor
+	}{
+		{"ANSI black", Black, RGB(0, 0, 0)},
+		{"ANSI red", Red, RGB(170, 0, 0)},
+		{"ANSI bright white", BrightWhite, RGB(255, 255, 255)},
+		{"256 standard entry", ANSI256Color(9), RGB(255, 85, 85)},
+		{"256 cube origin", ANSI256Color(16), RGB(0, 0, 0)},
+		{"256 cube orange", ANSI256Color(208), RGB(255, 135, 0)},
+		{"256 cube blue", ANSI256Color(21), RGB(0, 0, 255)},
+		{"256 cube white", ANSI256Color(231), RGB(255, 255, 255)},
+		{"256 first gray", ANSI256Color(232), RGB(8, 8, 8)},
+		{"256 last gray", ANSI256Color(255), RGB(238, 238, 238)},
+		{"RGB unchanged", RGB(12, 34, 56), RGB(12, 34, 56)},
+		{"nil", nil, RGB(0, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		if got := ToRGB(tt.color); got != tt.want {
+			t.Errorf("%s: ToRGB(%v) = %v, want %v", tt.name, tt.color, got, tt.want)
+		}
+	}
+}
+
+func TestRGBColorDarkenLighten(t *testing.T) {
+	base := RGB(200, 100, 50) // HSL(20°, 60%, 49%)
+	_, _, baseL := base.toHSL()
+
+	darker := base.Darken(0.5)
+	if _, _, l := darker.toHSL(); math.Abs(l-baseL/2) > 0.01 {
+		t.Errorf("Darken(0.5) lightness = %.3f, want %.3f", l, baseL/2)
+	}
+	if h, _, _ := darker.toHSL(); math.Abs(h-20) > 2 {
+		t.Errorf("Darken(0.5) hue = %.1f, want 20", h)
+	}
+
+	lighter := base.Lighten(0.5)
+	if _, _, l := lighter.toHSL(); math.Abs(l-(baseL+1)/2) > 0.01 {
+		t.Errorf("Lighten(0.5) lightness = %.3f, want %.3f", l, (baseL+1)/2)
+	}
+
+	tests := []struct {
+		name string
+		got  RGBColor
+		want RGBColor
+	}{
+		{"Darken(0)", base.Darken(0), base},
+		{"Darken(1)", base.Darken(1), RGB(0, 0, 0)},
+		{"Darken(2)", base.Darken(2), RGB(0, 0, 0)},
+		{"Darken(-1)", base.Darken(-1), base},
+		{"Lighten(1)", base.Lighten(1), RGB(255, 255, 255)},
+		{"Lighten(5)", base.Lighten(5), RGB(255, 255, 255)},
+		{"Lighten white", RGB(255, 255, 255).Lighten(0.5), RGB(255, 255, 255)},
+		{"Darken black", RGB(0, 0, 0).Darken(0.5), RGB(0, 0, 0)},
+		{"Darken gray", Gray(200).Darken(0.5), Gray(100)},
+		{"Desaturate(1)", RGB(255, 0, 0).Desaturate(1), Gray(128)},
+		{"Saturate(1)", RGB(150, 100, 100).Saturate(1), RGB(250, 0, 0)},
+		{"Saturate gray", Gray(90).Saturate(1), Gray(90)},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestContrastColor(t *testing.T) {
+	black := RGB(0, 0, 0)
+	white := RGB(255, 255, 255)
+
+	tests := []struct {
+		name string
+		bg   Color
+		want Color
+	}{
+		{"dark rgb", RGB(20, 30, 60), white},
+		{"light rgb", RGB(240, 240, 200), black},
+		{"ansi blue", Blue, white},
+		{"ansi bright yellow", BrightYellow, black},
+		{"256 dark blue", ANSI256Color(17), white},
+		{"256 light gray", ANSI256Color(252), black},
+	}
+
+	for _, tt := range tests {
+		if got := ContrastColor(tt.bg); !ColorEqual(got, tt.want) {
+			t.Errorf("ContrastColor(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Just either side of the luminance threshold
+	dark, light := Gray(117), Gray(118)
+	if dark.luminance() >= luminanceThreshold || light.luminance() <= luminanceThreshold {
+		t.Fatalf("Expected grays 117 and 118 to straddle the threshold")
+	}
+	if got := ContrastColor(dark); !ColorEqual(got, white) {
+		t.Errorf("ContrastColor(gray 117) = %v, want white", got)
+	}
+	if got := ContrastColor(light); !ColorEqual(got, black) {
+		t.Errorf("ContrastColor(gray 118) = %v, want black", got)
+	}
+}
+
+func TestGradient(t *testing.T) {
+	colors := Gradient(RGB(255, 0, 0), RGB(0, 0, 255), 5)
+	want := []RGBColor{RGB(255, 0, 0), RGB(191, 0, 64), RGB(128, 0, 128), RGB(64, 0, 191), RGB(0, 0, 255)}
+	if len(colors) != len(want) {
+		t.Fatalf("Expected %d colors, got %d", len(want), len(colors))
+	}
+	for i := range want {
+		if colors[i] != want[i] {
+			t.Errorf("Gradient[%d] = %v, want %v", i, colors[i], want[i])
+		}
+	}
+
+	// Palette colors are resolved to RGB
+	if got := Gradient(BrightRed, Black, 2); got[0] != ToRGB(BrightRed) || got[1] != ToRGB(Black) {
+		t.Errorf("Expected palette endpoints, got %v", got)
+	}
+
+	if got := Gradient(Red, Blue, 1); len(got) != 1 || got[0] != ToRGB(Red) {
+		t.Errorf("Expected a single step to give the start color, got %v", got)
+	}
+	if got := Gradient(Red, Blue, 0); got != nil {
+		t.Errorf("Expected nil for zero steps, got %v", got)
+	}
+}
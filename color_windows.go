@@ -0,0 +1,53 @@
+//go:build windows
+
+package rich
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// detectPlatformColorMode enables ANSI escape processing on Windows consoles
+// and reports the color mode to use. When ok is false, the generic
+// environment-based detection in detectColorMode continues.
+//
+// Windows 10 and later interpret ANSI escape sequences only after
+// ENABLE_VIRTUAL_TERMINAL_PROCESSING is set on the console handle.
+// If it can't be enabled (older Windows, or not a console), colors are disabled.
+func detectPlatformColorMode(f *os.File) (mode ColorMode, ok bool) {
+	return windowsColorMode(enableVirtualTerminal(f))
+}
+
+// enableVirtualTerminal turns on ANSI escape processing for the console
+// attached to f. Returns false if f is not a console or the mode can't be set.
+func enableVirtualTerminal(f *os.File) bool {
+	handle := windows.Handle(f.Fd())
+
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		return false
+	}
+	if mode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
+		return true
+	}
+	return windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING) == nil
+}
+
+// windowsColorMode picks a color mode once virtual terminal support is known.
+//   - VT processing unavailable → ColorModeNone
+//   - WT_SESSION set (Windows Terminal) → ColorModeTrueColor
+//   - TERM or COLORTERM set (e.g. MSYS, Cygwin) → defer to generic detection
+//   - Otherwise → ColorModeStandard (conhost with VT processing)
+func windowsColorMode(vtEnabled bool) (mode ColorMode, ok bool) {
+	if !vtEnabled {
+		return ColorModeNone, true
+	}
+	if os.Getenv("WT_SESSION") != "" {
+		return ColorModeTrueColor, true
+	}
+	if os.Getenv("TERM") != "" || os.Getenv("COLORTERM") != "" {
+		return ColorModeNone, false
+	}
+	return ColorModeStandard, true
+}
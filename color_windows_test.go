@@ -0,0 +1,45 @@
+//go:build windows
+
+package rich
+
+import (
+	"os"
+	"testing"
+)
+
+func TestWindowsColorMode(t *testing.T) {
+	t.Setenv("WT_SESSION", "")
+	t.Setenv("TERM", "")
+	t.Setenv("COLORTERM", "")
+
+	if mode, ok := windowsColorMode(false); !ok || mode != ColorModeNone {
+		t.Errorf("Expected ColorModeNone without VT processing, got %v (ok=%v)", mode, ok)
+	}
+
+	if mode, ok := windowsColorMode(true); !ok || mode != ColorModeStandard {
+		t.Errorf("Expected ColorModeStandard on a VT console, got %v (ok=%v)", mode, ok)
+	}
+
+	t.Setenv("WT_SESSION", "b2f4a9e0-0000-0000-0000-000000000000")
+	if mode, ok := windowsColorMode(true); !ok || mode != ColorModeTrueColor {
+		t.Errorf("Expected ColorModeTrueColor in Windows Terminal, got %v (ok=%v)", mode, ok)
+	}
+
+	t.Setenv("WT_SESSION", "")
+	t.Setenv("TERM", "xterm-256color")
+	if _, ok := windowsColorMode(true); ok {
+		t.Error("Expected TERM to defer to generic detection")
+	}
+}
+
+func TestEnableVirtualTerminalNotConsole(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if enableVirtualTerminal(f) {
+		t.Error("Expected enableVirtualTerminal to fail for a regular file")
+	}
+}
@@ -0,0 +1,162 @@
+package rich
+
+import "strings"
+
+// Columns is a renderable that places other renderables side by side.
+// Each child is rendered as a block of lines at its allotted width, and the
+// blocks are stitched together line by line with a gap between them. Shorter
+// children are padded with blank lines to the height of the tallest one, so
+// every row of the output lines up.
+//
+// Create one with NewColumns.
+type Columns struct {
+	children []Renderable // Renderables to place, left to right
+	gap      int          // Spaces between adjacent columns
+}
+
+// NewColumns creates a renderable that renders the given children next to
+// each other, separated by a one-space gap. Nil children are skipped.
+//
+// Column widths are chosen as follows:
+//   - If every child's natural (maximum) width fits, each child gets it
+//   - Otherwise the available width is split evenly between the children
+//
+// Example:
+//
+//	cpu := panel.New(cpuStats).Title("CPU")
+//	mem := panel.New(memStats).Title("Memory")
+//	console.Renderln(rich.NewColumns(cpu, mem).Gap(2))
+func NewColumns(children ...Renderable) *Columns {
+	c := &Columns{gap: 1}
+	for _, child := range children {
+		if child != nil {
+			c.children = append(c.children, child)
+		}
+	}
+	return c
+}
+
+// Gap sets the number of spaces between adjacent columns.
+// Negative values are treated as 0. Default is 1.
+//
+// Example:
+//
+//	rich.NewColumns(left, right).Gap(4)
+func (c *Columns) Gap(gap int) *Columns {
+	c.gap = max(gap, 0)
+	return c
+}
+
+// Render implements Renderable.
+// Every output line is padded to the full block width, so the result is a
+// rectangle of text that composes with Group and Align.
+func (c *Columns) Render(console *Console, width int) Segments {
+	if len(c.children) == 0 {
+		return nil
+	}
+
+	widths := c.columnWidths(console, width)
+
+	// Render each child into its own block of lines
+	blocks := make([][]Segments, len(c.children))
+	height := 0
+	for i, child := range c.children {
+		blocks[i] = blockLines(child.Render(console, widths[i]))
+		height = max(height, len(blocks[i]))
+	}
+
+	gap := Segment{Text: strings.Repeat(" ", c.gap)}
+
+	var result Segments
+	for row := 0; row < height; row++ {
+		if row > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+		for i, block := range blocks {
+			if i > 0 && c.gap > 0 {
+				result = append(result, gap)
+			}
+			var line Segments
+			if row < len(block) {
+				line = block[row]
+			}
+			result = append(result, fitLine(line, widths[i])...)
+		}
+	}
+
+	return result
+}
+
+// Measure implements Measurable.
+// The columns need the sum of their children's widths plus the gaps.
+func (c *Columns) Measure(console *Console, maxWidth int) Measurement {
+	var m Measurement
+	for i, child := range c.children {
+		if i > 0 {
+			m = m.Add(Measurement{Minimum: c.gap, Maximum: c.gap})
+		}
+		m = m.Add(MeasureRenderable(console, child, maxWidth))
+	}
+	return m
+}
+
+// columnWidths allots a width to each child within the total width.
+func (c *Columns) columnWidths(console *Console, width int) []int {
+	n := len(c.children)
+	available := max(width-c.gap*(n-1), n)
+
+	widths := make([]int, n)
+	total := 0
+	for i, child := range c.children {
+		widths[i] = max(MeasureRenderable(console, child, available).Maximum, 1)
+		total += widths[i]
+	}
+	if total <= available {
+		return widths
+	}
+
+	// Not enough room for natural widths: split evenly, giving any
+	// remainder to the leftmost columns
+	for i := range widths {
+		widths[i] = available / n
+		if i < available%n {
+			widths[i]++
+		}
+	}
+	return widths
+}
+
+// blockLines splits rendered output into lines, ignoring a single trailing
+// newline so it doesn't add a blank line to the block.
+func blockLines(segments Segments) []Segments {
+	lines := segments.SplitLines()
+	if n := len(lines); n > 1 && len(lines[n-1]) == 0 {
+		lines = lines[:n-1]
+	}
+	return lines
+}
+
+// fitLine crops or pads a line to exactly width display columns.
+// A wide character that would straddle the edge is replaced by padding.
+func fitLine(line Segments, width int) Segments {
+	var result Segments
+	used := 0
+
+	for _, seg := range line {
+		if used >= width {
+			break
+		}
+		segWidth := DisplayWidth(seg.Text)
+		if used+segWidth > width {
+			seg.Text = cutToWidth(seg.Text, width-used)
+			segWidth = DisplayWidth(seg.Text)
+		}
+		result = append(result, seg)
+		used += segWidth
+	}
+
+	if used < width {
+		result = append(result, Segment{Text: strings.Repeat(" ", width-used)})
+	}
+	return result
+}
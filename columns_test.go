@@ -0,0 +1,61 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColumns(t *testing.T) {
+	console := NewConsole(nil)
+
+	cols := NewColumns(
+		NewRenderableString("one\ntwo\nthree", NewStyle()),
+		NewRenderableString("a", NewStyle()),
+		NewRenderableString("日本", NewStyle()),
+	).Gap(2)
+
+	want := []string{
+		"one    a  日本",
+		"two           ",
+		"three         ",
+	}
+	got := strings.Split(cols.Render(console, 80).String(), "\n")
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Columns output = %q, want %q", got, want)
+	}
+}
+
+func TestColumnsEvenSplit(t *testing.T) {
+	console := NewConsole(nil)
+
+	// Fills want the whole width, so the space is split evenly
+	cols := NewColumns(
+		FillPattern("a", NewStyle()),
+		FillPattern("b", NewStyle()),
+		FillPattern("c", NewStyle()),
+	)
+
+	want := "aaaa bbb ccc"
+	if got := cols.Render(console, 12).String(); got != want {
+		t.Errorf("Columns output = %q, want %q", got, want)
+	}
+}
+
+func TestFitLine(t *testing.T) {
+	tests := []struct {
+		line  Segments
+		width int
+		want  string
+	}{
+		{Segments{{Text: "abc"}}, 5, "abc  "},
+		{Segments{{Text: "abc"}, {Text: "def"}}, 4, "abcd"},
+		{Segments{{Text: "日本"}}, 3, "日 "},
+		{nil, 2, "  "},
+	}
+
+	for _, tt := range tests {
+		if got := fitLine(tt.line, tt.width).String(); got != tt.want {
+			t.Errorf("fitLine(%q, %d) = %q, want %q", tt.line.String(), tt.width, got, tt.want)
+		}
+	}
+}
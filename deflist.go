@@ -0,0 +1,158 @@
+package rich
+
+import "strings"
+
+// DefList is a renderable that shows key-value pairs in two aligned
+// columns, such as settings in a config dump. Keys are padded to the width
+// of the longest key, and values word-wrap within the remaining width, with
+// continuation lines staying in the value column.
+//
+// Create one with NewDefList.
+type DefList struct {
+	keys       []string // Keys, top to bottom
+	values     []string // Value for each key
+	keyAlign   Align    // Alignment of keys within the key column
+	keyStyle   Style    // Style applied to keys
+	valueStyle Style    // Style applied to values
+	separator  string   // Text between the key and value columns
+}
+
+// NewDefList creates an empty definition list. Keys are left-aligned and
+// bold by default, and separated from their values by two spaces.
+//
+// Example:
+//
+//	console.Renderln(rich.NewDefList().
+//		Add("Environment", "production").
+//		Add("Region", "eu-west-1").
+//		Add("Description", "Primary cluster serving all customer traffic"))
+func NewDefList() *DefList {
+	return &DefList{
+		keyStyle:  NewStyle().Bold(),
+		separator: "  ",
+	}
+}
+
+// Add appends a key-value pair to the list.
+// Returns the list for method chaining.
+//
+// Example:
+//
+//	list := rich.NewDefList()
+//	for _, key := range keys {
+//		list.Add(key, settings[key])
+//	}
+func (d *DefList) Add(key, value string) *DefList {
+	d.keys = append(d.keys, key)
+	d.values = append(d.values, value)
+	return d
+}
+
+// KeyAlign sets the alignment of keys within the key column.
+// AlignRight lines the keys up against the values. Default is AlignLeft.
+//
+// Example:
+//
+//	list := rich.NewDefList().KeyAlign(rich.AlignRight)
+func (d *DefList) KeyAlign(align Align) *DefList {
+	d.keyAlign = align
+	return d
+}
+
+// KeyStyle sets the style applied to keys. Default is bold.
+//
+// Example:
+//
+//	list := rich.NewDefList().KeyStyle(rich.NewStyle().Foreground(rich.Cyan))
+func (d *DefList) KeyStyle(style Style) *DefList {
+	d.keyStyle = style
+	return d
+}
+
+// ValueStyle sets the style applied to values. Default is no style.
+//
+// Example:
+//
+//	list := rich.NewDefList().ValueStyle(rich.NewStyle().Italic())
+func (d *DefList) ValueStyle(style Style) *DefList {
+	d.valueStyle = style
+	return d
+}
+
+// Separator sets the text placed between the key and value columns, such as
+// ": " or " = ". Default is two spaces.
+//
+// Example:
+//
+//	list := rich.NewDefList().Separator(" = ")
+func (d *DefList) Separator(separator string) *DefList {
+	d.separator = separator
+	return d
+}
+
+// keyWidth returns the display width of the longest key.
+func (d *DefList) keyWidth() int {
+	width := 0
+	for _, key := range d.keys {
+		width = max(width, DisplayWidth(key))
+	}
+	return width
+}
+
+// Render implements Renderable.
+func (d *DefList) Render(console *Console, width int) Segments {
+	keyWidth := d.keyWidth()
+	sepWidth := DisplayWidth(d.separator)
+	valueWidth := max(width-keyWidth-sepWidth, 1)
+	continuation := Segment{Text: strings.Repeat(" ", keyWidth+sepWidth)}
+
+	var result Segments
+	for i, key := range d.keys {
+		value := Segments{{Text: d.values[i], Style: d.valueStyle}}
+		for j, line := range value.Wrap(valueWidth) {
+			if i > 0 || j > 0 {
+				result = append(result, Segment{Text: "\n"})
+			}
+			if j == 0 {
+				left, right := alignPadding(DisplayWidth(key), keyWidth, d.keyAlign)
+				if left > 0 {
+					result = append(result, Segment{Text: strings.Repeat(" ", left)})
+				}
+				result = append(result, Segment{Text: key, Style: d.keyStyle})
+				result = append(result, Segment{Text: strings.Repeat(" ", right) + d.separator})
+			} else {
+				result = append(result, continuation)
+			}
+			result = append(result, line...)
+		}
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// The list needs the key column and separator, plus at least the longest
+// word of any value and at most the longest value.
+func (d *DefList) Measure(console *Console, maxWidth int) Measurement {
+	if len(d.keys) == 0 {
+		return Measurement{}
+	}
+
+	fixed := d.keyWidth() + DisplayWidth(d.separator)
+	var m Measurement
+	for _, value := range d.values {
+		for _, line := range strings.Split(value, "\n") {
+			m.Minimum = max(m.Minimum, longestWord(line))
+			m.Maximum = max(m.Maximum, DisplayWidth(line))
+		}
+	}
+	return m.Add(Measurement{Minimum: fixed, Maximum: fixed})
+}
+
+// longestWord returns the display width of the longest space-separated word in s.
+func longestWord(s string) int {
+	longest := 0
+	for _, word := range strings.Fields(s) {
+		longest = max(longest, DisplayWidth(word))
+	}
+	return longest
+}
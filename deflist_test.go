@@ -0,0 +1,64 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefList(t *testing.T) {
+	console := NewConsole(nil)
+
+	list := NewDefList().
+		Add("Name", "api").
+		Add("Environment", "production").
+		Add("Note", "Serves all public traffic for the dashboard")
+	got := strings.Split(list.Render(console, 32).String(), "\n")
+
+	// Values start in the same column, and wrapped lines stay under them
+	want := []string{
+		"Name         api",
+		"Environment  production",
+		"Note         Serves all public",
+		"             traffic for the",
+		"             dashboard",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("DefList output = %q, want %q", got, want)
+	}
+
+	if m := list.Measure(console, 80); m.Minimum != 23 || m.Maximum != 56 {
+		t.Errorf("Expected measurement {23 56}, got %+v", m)
+	}
+}
+
+func TestDefListStyles(t *testing.T) {
+	console := NewConsole(nil)
+
+	keyStyle := NewStyle().Foreground(Cyan)
+	valueStyle := NewStyle().Italic()
+	list := NewDefList().
+		KeyAlign(AlignRight).
+		KeyStyle(keyStyle).
+		ValueStyle(valueStyle).
+		Separator(": ").
+		Add("a", "1").
+		Add("abc", "2")
+
+	segments := list.Render(console, 20)
+	if got := segments.String(); got != "  a: 1\nabc: 2" {
+		t.Errorf("DefList output = %q, want %q", got, "  a: 1\nabc: 2")
+	}
+
+	for _, seg := range segments {
+		switch seg.Text {
+		case "a", "abc":
+			if !seg.Style.Equal(keyStyle) {
+				t.Errorf("Key %q style = %s, want %s", seg.Text, seg.Style, keyStyle)
+			}
+		case "1", "2":
+			if !seg.Style.Equal(valueStyle) {
+				t.Errorf("Value %q style = %s, want %s", seg.Text, seg.Style, valueStyle)
+			}
+		}
+	}
+}
@@ -12,6 +12,9 @@
 // - Tables with customizable borders, alignment, and styling
 // - Panels (bordered containers) for grouping content
 // - Horizontal rules and dividers
+// - Bulleted and numbered lists with hanging indents
+// - Definition lists of aligned key-value pairs
+// - Live displays that redraw a renderable in place
 // - Renderable interface for custom content types
 //
 // # Basic Usage
@@ -57,6 +60,7 @@
 //	console.PrintMarkupln("[bold red on white]Styled[/]")
 //	console.PrintMarkupln("[#FF0000]Hex color[/]")
 //	console.PrintMarkupln("[rgb(255,0,0)]RGB color[/]")
+//	console.PrintMarkupln("[color(196) on color(21)]256-color palette[/]")
 //
 // Escape brackets with double brackets:
 //
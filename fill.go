@@ -0,0 +1,51 @@
+package rich
+
+import "strings"
+
+// Fill is a renderable that repeats a pattern across the full render width.
+// It is useful for decorative separators beyond what Rule provides.
+// Create one with FillPattern.
+type Fill struct {
+	Pattern string // Text repeated to fill the width
+	Style   Style  // Style applied to the fill
+}
+
+// FillPattern returns a renderable that repeats pattern to exactly fill the
+// render width. The last repeat is cut short if needed. Widths are measured in
+// display columns, so wide characters such as emoji are handled correctly; if
+// a wide character would straddle the edge, a space is used in its place.
+//
+// An empty pattern fills the width with spaces.
+//
+// Example:
+//
+//	console.Renderln(rich.FillPattern("=-", rich.NewStyle().Dim())) // =-=-=-=-...
+//	console.Renderln(rich.FillPattern("🌊", rich.NewStyle()))
+func FillPattern(pattern string, style Style) Renderable {
+	return &Fill{Pattern: pattern, Style: style}
+}
+
+// Render implements Renderable.
+func (f *Fill) Render(console *Console, width int) Segments {
+	if width <= 0 {
+		return nil
+	}
+
+	pattern := f.Pattern
+	patternWidth := DisplayWidth(pattern)
+	if patternWidth == 0 {
+		pattern, patternWidth = " ", 1
+	}
+
+	repeats := width / patternWidth
+	text := strings.Repeat(pattern, repeats)
+
+	// Trim the final partial repeat, padding if a wide character didn't fit
+	remaining := width - repeats*patternWidth
+	if remaining > 0 {
+		partial := cutToWidth(pattern, remaining)
+		text += partial + strings.Repeat(" ", remaining-DisplayWidth(partial))
+	}
+
+	return Segments{{Text: text, Style: f.Style}}
+}
@@ -0,0 +1,29 @@
+package rich
+
+import "testing"
+
+func TestFillPattern(t *testing.T) {
+	console := NewConsole(nil)
+
+	tests := []struct {
+		pattern string
+		width   int
+		want    string
+	}{
+		{"=-", 10, "=-=-=-=-=-"},
+		{"=-", 9, "=-=-=-=-="},
+		{"abc", 7, "abcabca"},
+		{"🌊", 5, "🌊🌊 "}, // Wide character can't be split
+		{"", 3, "   "},
+	}
+
+	for _, tt := range tests {
+		got := FillPattern(tt.pattern, NewStyle()).Render(console, tt.width).String()
+		if got != tt.want {
+			t.Errorf("FillPattern(%q) at width %d = %q, want %q", tt.pattern, tt.width, got, tt.want)
+		}
+		if w := DisplayWidth(got); w != tt.width {
+			t.Errorf("FillPattern(%q) at width %d is %d columns wide", tt.pattern, tt.width, w)
+		}
+	}
+}
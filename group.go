@@ -0,0 +1,79 @@
+package rich
+
+// Group is a renderable that stacks other renderables vertically.
+// Each child is rendered as a block at the full available width, and the
+// resulting lines are concatenated in order. Unlike Lines, which simply joins
+// its children with newlines, Group treats every child's output as a block
+// of lines, so multi-line renderables such as tables and panels compose
+// cleanly - no blank lines from trailing newlines, no lines run together.
+//
+// Create one with NewGroup.
+type Group struct {
+	children []Renderable // Renderables to stack, top to bottom
+}
+
+// NewGroup creates a renderable that renders the given children one below
+// the other. Nil children are skipped.
+//
+// Example:
+//
+//	group := rich.NewGroup(
+//		panel.New("Nightly build report").Title("CI"),
+//		results, // a *table.Table
+//	)
+//	console.Renderln(group)
+func NewGroup(children ...Renderable) *Group {
+	g := &Group{}
+	for _, child := range children {
+		if child != nil {
+			g.children = append(g.children, child)
+		}
+	}
+	return g
+}
+
+// Add appends renderables to the bottom of the group.
+// Returns the group for method chaining. Nil renderables are skipped.
+//
+// Example:
+//
+//	group := rich.NewGroup().Add(header).Add(body, footer)
+func (g *Group) Add(children ...Renderable) *Group {
+	for _, child := range children {
+		if child != nil {
+			g.children = append(g.children, child)
+		}
+	}
+	return g
+}
+
+// Render implements Renderable.
+// Each child is rendered at the full width and split into lines; a single
+// trailing newline in a child's output does not produce a blank line.
+// Lines are separated by newlines, with no newline after the last one.
+func (g *Group) Render(console *Console, width int) Segments {
+	var result Segments
+	first := true
+
+	for _, child := range g.children {
+		for _, line := range blockLines(child.Render(console, width)) {
+			if !first {
+				result = append(result, Segment{Text: "\n"})
+			}
+			first = false
+			result = append(result, line...)
+		}
+	}
+
+	return result
+}
+
+// Measure implements Measurable.
+// The group is as wide as its widest child.
+func (g *Group) Measure(console *Console, maxWidth int) Measurement {
+	var m Measurement
+	for _, child := range g.children {
+		m = m.Max(MeasureRenderable(console, child, maxWidth))
+	}
+	return m
+}
@@ -0,0 +1,31 @@
+package rich
+
+import "testing"
+
+func TestGroup(t *testing.T) {
+	console := NewConsole(nil)
+
+	group := NewGroup(
+		FillPattern("─", NewStyle()),
+		NewRenderableString("line 1\nline 2\n", NewStyle()),
+		nil,
+		NewRenderableString("last", NewStyle().Bold()),
+	)
+
+	want := "──────────\nline 1\nline 2\nlast"
+	if got := group.Render(console, 10).String(); got != want {
+		t.Errorf("Group output = %q, want %q", got, want)
+	}
+
+	if m := group.Measure(console, 80); m.Minimum != 80 || m.Maximum != 80 {
+		t.Errorf("Expected measurement {80 80}, got %+v", m)
+	}
+}
+
+func TestGroupEmpty(t *testing.T) {
+	console := NewConsole(nil)
+
+	if got := NewGroup().Render(console, 10); len(got) != 0 {
+		t.Errorf("Expected empty group to render nothing, got %q", got.String())
+	}
+}
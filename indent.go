@@ -0,0 +1,62 @@
+package rich
+
+// Indented is a renderable that prefixes every line of another renderable,
+// for blockquotes, nested log context, and similar indented blocks.
+// Create one with Indent.
+type Indented struct {
+	child  Renderable // The renderable being indented
+	prefix string     // Text placed before every line
+	style  Style      // Style of the prefix
+}
+
+// Indent wraps child so every line it renders starts with prefix, drawn in
+// style. The child is rendered with the prefix's width subtracted from the
+// available width, so the indented block still fits. The child's own styles
+// are kept; only the prefix uses style.
+//
+// Example:
+//
+//	// A blockquote
+//	quote := rich.NewRenderableString("Simple is better than complex.", rich.NewStyle().Italic())
+//	console.Renderln(rich.Indent(quote, "│ ", rich.NewStyle().Dim()))
+//
+//	// Nested context for a group of log lines
+//	console.Renderln(rich.Indent(details, "    ", rich.NewStyle()))
+func Indent(child Renderable, prefix string, style Style) *Indented {
+	return &Indented{
+		child:  child,
+		prefix: prefix,
+		style:  style,
+	}
+}
+
+// Render implements Renderable.
+func (in *Indented) Render(console *Console, width int) Segments {
+	if in.child == nil {
+		return nil
+	}
+
+	prefixWidth := DisplayWidth(in.prefix)
+	lines := blockLines(in.child.Render(console, max(width-prefixWidth, 1)))
+
+	var result Segments
+	for i, line := range lines {
+		if i > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+		result = append(result, Segment{Text: in.prefix, Style: in.style})
+		result = append(result, line...)
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// The indented block needs the child's width plus the prefix.
+func (in *Indented) Measure(console *Console, maxWidth int) Measurement {
+	prefixWidth := DisplayWidth(in.prefix)
+	var m Measurement
+	if in.child != nil {
+		m = MeasureRenderable(console, in.child, max(maxWidth-prefixWidth, 1))
+	}
+	return m.Add(Measurement{Minimum: prefixWidth, Maximum: prefixWidth})
+}
@@ -0,0 +1,50 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndent(t *testing.T) {
+	console := NewConsole(nil)
+	prefixStyle := NewStyle().Dim()
+	textStyle := NewStyle().Italic()
+
+	indented := Indent(NewRenderableString("first\nsecond\nthird", textStyle), "│ ", prefixStyle)
+	segments := indented.Render(console, 20)
+
+	want := []string{"│ first", "│ second", "│ third"}
+	if got := strings.Split(segments.String(), "\n"); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Indent output = %q, want %q", got, want)
+	}
+
+	// Every line starts with the prefix in its own style; the child keeps its style
+	for i, line := range segments.SplitLines() {
+		if line[0].Text != "│ " || !line[0].Style.Equal(prefixStyle) {
+			t.Errorf("Line %d: expected dim prefix, got %q (%s)", i, line[0].Text, line[0].Style)
+		}
+		if !line[1].Style.Equal(textStyle) {
+			t.Errorf("Line %d: expected child style %s, got %s", i, textStyle, line[1].Style)
+		}
+	}
+
+	if m := indented.Measure(console, 20); m.Maximum != 8 {
+		t.Errorf("Expected Maximum=8, got %d", m.Maximum)
+	}
+}
+
+func TestIndentChildWidth(t *testing.T) {
+	console := NewConsole(nil)
+
+	// The child is rendered narrower so the prefixed lines still fit
+	indented := Indent(FillPattern("-", NewStyle()), "> ", NewStyle())
+	if got := indented.Render(console, 9).String(); got != "> -------" {
+		t.Errorf("Indent output = %q, want %q", got, "> -------")
+	}
+
+	// Indents nest
+	nested := Indent(Indent(NewRenderableString("a\nb", NewStyle()), "> ", NewStyle()), "> ", NewStyle())
+	if got := nested.Render(console, 20).String(); got != "> > a\n> > b" {
+		t.Errorf("Nested indent = %q, want %q", got, "> > a\n> > b")
+	}
+}
@@ -83,6 +83,11 @@ const (
 	// SGR code: 29
 	ResetStrikethrough = "\x1b[29m"
 
+	// ResetUnderlineColor restores the default underline color (the text color)
+	// without affecting other attributes.
+	// SGR code: 59
+	ResetUnderlineColor = "\x1b[59m"
+
 	// Cursor control - move the cursor position
 
 	// CursorUp moves the cursor up one line.
@@ -109,6 +114,15 @@ const (
 	// CSI code: F
 	CursorPrevLine = "\x1b[F"
 
+	// SaveCursor saves the current cursor position.
+	// Restore it later with RestoreCursor.
+	// CSI code: s
+	SaveCursor = "\x1b[s"
+
+	// RestoreCursor moves the cursor to the position saved by SaveCursor.
+	// CSI code: u
+	RestoreCursor = "\x1b[u"
+
 	// Screen control - manipulate screen content
 
 	// ClearScreen clears the entire screen.
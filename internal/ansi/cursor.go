@@ -0,0 +1,80 @@
+package ansi
+
+import (
+	"strconv"
+	"strings"
+)
+
+// LineStart moves the cursor to the start of the line (by moving left 1000
+// columns, which stops at the first column) and clears the line, ready for
+// a fresh line of output. Used when redrawing output in place.
+var LineStart = CursorBackN(1000) + ClearLineToEnd
+
+// CursorUpN returns a sequence that moves the cursor up n lines.
+// Returns an empty string for n <= 0, since terminals treat a count of 0 as 1.
+//
+// Example:
+//
+//	w.WriteString(ansi.CursorUpN(3))
+func CursorUpN(n int) string {
+	return cursorMove(n, 'A')
+}
+
+// CursorDownN returns a sequence that moves the cursor down n lines.
+// Returns an empty string for n <= 0.
+func CursorDownN(n int) string {
+	return cursorMove(n, 'B')
+}
+
+// CursorForwardN returns a sequence that moves the cursor right n columns.
+// Returns an empty string for n <= 0.
+func CursorForwardN(n int) string {
+	return cursorMove(n, 'C')
+}
+
+// CursorBackN returns a sequence that moves the cursor left n columns.
+// The cursor stops at the first column, so a large n moves to the line start.
+// Returns an empty string for n <= 0.
+func CursorBackN(n int) string {
+	return cursorMove(n, 'D')
+}
+
+// CursorTo returns a sequence that moves the cursor to the given row and
+// column. Both are 1-based, with (1, 1) the top-left corner of the screen;
+// smaller values are treated as 1.
+//
+// Example:
+//
+//	w.WriteString(ansi.CursorTo(1, 1) + ansi.ClearScreen)
+func CursorTo(row, col int) string {
+	if row < 1 {
+		row = 1
+	}
+	if col < 1 {
+		col = 1
+	}
+	return "\x1b[" + strconv.Itoa(row) + ";" + strconv.Itoa(col) + "H"
+}
+
+// ClearLines returns a sequence that clears n lines, starting with the
+// cursor's line, and moves the cursor back to the start of the first one.
+// Returns an empty string for n <= 0.
+//
+// Example:
+//
+//	// Erase the last 3 lines of output
+//	w.WriteString(ansi.CursorUpN(3) + ansi.ClearLines(3))
+func ClearLines(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(LineStart+"\n", n) + CursorUpN(n)
+}
+
+// cursorMove builds a relative cursor movement sequence: ESC [ n command.
+func cursorMove(n int, command byte) string {
+	if n <= 0 {
+		return ""
+	}
+	return "\x1b[" + strconv.Itoa(n) + string(command)
+}
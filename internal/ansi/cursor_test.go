@@ -0,0 +1,31 @@
+package ansi
+
+import "testing"
+
+func TestCursorSequences(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"up", CursorUpN(3), "\x1b[3A"},
+		{"down", CursorDownN(2), "\x1b[2B"},
+		{"forward", CursorForwardN(10), "\x1b[10C"},
+		{"back", CursorBackN(1000), "\x1b[1000D"},
+		{"up zero", CursorUpN(0), ""},
+		{"down negative", CursorDownN(-1), ""},
+		{"to", CursorTo(5, 12), "\x1b[5;12H"},
+		{"to origin clamp", CursorTo(0, -3), "\x1b[1;1H"},
+		{"save", SaveCursor, "\x1b[s"},
+		{"restore", RestoreCursor, "\x1b[u"},
+		{"line start", LineStart, "\x1b[1000D\x1b[K"},
+		{"clear lines", ClearLines(2), "\x1b[1000D\x1b[K\n\x1b[1000D\x1b[K\n\x1b[2A"},
+		{"clear zero lines", ClearLines(0), ""},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package ansi
 
 import (
+	"bytes"
 	"io"
 	"regexp"
+	"unicode/utf8"
 )
 
 // Writer wraps an io.Writer and provides ANSI-aware writing.
@@ -40,6 +42,114 @@ func (w *Writer) WriteString(s string) (n int, err error) {
 	return io.WriteString(w.w, s)
 }
 
+// StrippingWriter wraps an io.Writer and removes ANSI escape sequences from
+// everything written to it, so styled output can be sent to a log file or
+// other non-terminal sink as plain text. It removes the same sequences as
+// StripANSI.
+//
+// An escape sequence split across Write calls is held back until the rest of
+// it arrives. Call Flush when done to write out anything still held back
+// (an unfinished sequence, which is passed through unchanged).
+type StrippingWriter struct {
+	w       io.Writer // Underlying writer
+	pending []byte    // Unfinished escape sequence from the previous write
+}
+
+// NewStrippingWriter creates a writer that strips ANSI escape sequences
+// before passing output on to w.
+//
+// Example:
+//
+//	log := ansi.NewStrippingWriter(logFile)
+//	fmt.Fprint(io.MultiWriter(os.Stdout, log), styled)
+//	log.Flush()
+func NewStrippingWriter(w io.Writer) *StrippingWriter {
+	return &StrippingWriter{w: w}
+}
+
+// Write implements io.Writer.
+// Strips escape sequences from p and writes the remaining text to the
+// underlying writer. On success it reports all of p as written, including
+// any part held back as the start of an escape sequence.
+func (w *StrippingWriter) Write(p []byte) (n int, err error) {
+	data := append(w.pending, p...)
+
+	// Hold back a trailing sequence that may continue in the next write
+	cut := unfinishedSequence(data)
+	w.pending = append([]byte(nil), data[cut:]...)
+
+	if cut > 0 {
+		if _, err := w.w.Write(ansiRegex.ReplaceAll(data[:cut], nil)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// WriteString writes a string to the writer, stripping escape sequences.
+func (w *StrippingWriter) WriteString(s string) (n int, err error) {
+	return w.Write([]byte(s))
+}
+
+// Flush writes out any bytes held back as an unfinished escape sequence.
+// Since the sequence never completed, it is written unchanged.
+func (w *StrippingWriter) Flush() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+	_, err := w.w.Write(w.pending)
+	w.pending = nil
+	return err
+}
+
+// unfinishedSequence returns the index where an escape sequence starts that
+// runs to the end of b without being terminated, or len(b) if there is none.
+// Such a sequence may still be completed by the bytes that follow.
+func unfinishedSequence(b []byte) int {
+	last := bytes.LastIndexByte(b, 0x1b)
+	if last < 0 {
+		return len(b)
+	}
+
+	// A lone ESC at the end may be the first half of an OSC's ST terminator
+	// (ESC \), in which case the whole OSC is unfinished
+	if last == len(b)-1 {
+		if prev := bytes.LastIndexByte(b[:last], 0x1b); prev >= 0 &&
+			prev+1 < last && b[prev+1] == ']' && bytes.IndexByte(b[prev+2:last], 0x07) < 0 {
+			return prev
+		}
+		return last
+	}
+
+	switch rest := b[last+2:]; b[last+1] {
+	case '[':
+		// CSI: an optional private-mode prefix and parameters with no
+		// final letter yet
+		if len(rest) > 0 && bytes.IndexByte([]byte("?<=>"), rest[0]) >= 0 {
+			rest = rest[1:]
+		}
+		if len(bytes.Trim(rest, "0123456789;")) == 0 {
+			return last
+		}
+	case ']':
+		// OSC: no BEL terminator yet
+		if bytes.IndexByte(rest, 0x07) < 0 {
+			return last
+		}
+	}
+	return len(b)
+}
+
+// ansiRegex matches the escape sequences removed by StripANSI:
+//
+//	\x1b\[[?<=>]?[0-9;]*[a-zA-Z]    - CSI sequences: ESC [ params letter
+//	                                  (SGR codes, cursor movement, colors),
+//	                                  with an optional private-mode prefix
+//	                                  such as ? in ESC [ ? 25 l
+//	\x1b\][^\x07\x1b]*(\x07|\x1b\\)  - OSC sequences: ESC ] payload, terminated
+//	                                  by BEL or ST (ESC \)
+var ansiRegex = regexp.MustCompile(`\x1b\[[?<=>]?[0-9;]*[a-zA-Z]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
+
 // StripANSI removes all ANSI escape sequences from a string.
 // This is useful for:
 //   - Calculating the visible length of styled text
@@ -47,40 +157,36 @@ func (w *Writer) WriteString(s string) (n int, err error) {
 //   - Comparing text content without considering styling
 //
 // The function removes standard SGR (Select Graphic Rendition) sequences,
-// cursor movement codes, and other CSI (Control Sequence Introducer) sequences.
+// cursor movement codes, and other CSI (Control Sequence Introducer) sequences,
+// as well as OSC (Operating System Command) sequences such as hyperlinks and
+// window titles, whether terminated by BEL (\x07) or ST (\x1b\\).
 //
 // Example:
 //
 //	styled := "\x1b[1mBold\x1b[0m text"
 //	plain := ansi.StripANSI(styled)
 //	// plain == "Bold text"
+//
+//	link := "\x1b]8;;https://example.com\x1b\\Example\x1b]8;;\x1b\\"
+//	ansi.StripANSI(link) // "Example"
 func StripANSI(s string) string {
-	// Regular expression to match ANSI escape sequences:
-	// \x1b\[       - ESC[ (start of CSI sequence)
-	// [0-9;]*      - Zero or more digits or semicolons (parameters)
-	// [a-zA-Z]     - Letter (command character)
-	//
-	// This matches most common ANSI sequences including:
-	// - SGR codes: \x1b[1m, \x1b[31m, \x1b[0m
-	// - Cursor movement: \x1b[A, \x1b[2J
-	// - Colors: \x1b[38;2;255;0;0m
-	ansiRegex := regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
 	return ansiRegex.ReplaceAllString(s, "")
 }
 
 // Length returns the visible length of a string (excluding ANSI codes).
 // This counts only the visible characters, not the escape sequence bytes.
 //
-// Note: This returns byte length after stripping ANSI codes, not rune count.
-// For accurate character counting with multi-byte Unicode, use utf8.RuneCountInString
-// on the stripped result.
+// The length is the number of runes after stripping ANSI codes, matching
+// rich.Segments.Length, so multi-byte UTF-8 characters count once.
 //
 // Example:
 //
 //	styled := "\x1b[1mHello\x1b[0m"
 //	length := ansi.Length(styled)
 //	// length == 5 (counts only "Hello")
+//
+//	ansi.Length("\x1b[31mcafé\x1b[0m") // 4
 func Length(s string) int {
-	// Strip ANSI codes then measure length
-	return len(StripANSI(s))
+	// Strip ANSI codes then count runes
+	return utf8.RuneCountInString(StripANSI(s))
 }
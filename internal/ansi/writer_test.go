@@ -0,0 +1,96 @@
+package ansi
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain", "hello", "hello"},
+		{"sgr", "\x1b[1mBold\x1b[0m text", "Bold text"},
+		{"truecolor", "\x1b[38;2;255;0;0mRed\x1b[0m", "Red"},
+		{"hyperlink with ST", "\x1b]8;;https://example.com\x1b\\Example\x1b]8;;\x1b\\", "Example"},
+		{"hyperlink with BEL", "see \x1b]8;;https://example.com\x07docs\x1b]8;;\x07 here", "see docs here"},
+		{"styled hyperlink", "\x1b[4m\x1b]8;id=1;https://x.io\x1b\\link\x1b]8;;\x1b\\\x1b[0m", "link"},
+		{"window title", "\x1b]0;My Title\x07prompt", "prompt"},
+	}
+
+	for _, tt := range tests {
+		if got := StripANSI(tt.input); got != tt.want {
+			t.Errorf("%s: StripANSI(%q) = %q, want %q", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"plain", "Hello", 5},
+		{"styled", "\x1b[1mHello\x1b[0m", 5},
+		{"hyperlink", "\x1b]8;;https://example.com\x1b\\Example\x1b]8;;\x1b\\", 7},
+		{"accented", "\x1b[31mcafé\x1b[0m", 4},
+		{"accented plain", "naïve résumé", 12},
+		{"cjk", "\x1b[1m日本語\x1b[0m", 3},
+	}
+
+	for _, tt := range tests {
+		if got := Length(tt.input); got != tt.want {
+			t.Errorf("%s: Length(%q) = %d, want %d", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestStrippingWriter(t *testing.T) {
+	styled := "\x1b[1;31mError:\x1b[0m disk \x1b[38;2;255;128;0mfull\x1b[0m\n" +
+		"\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\ and \x1b]0;title\x07café\n" +
+		"\x1b[?25lworking\x1b[?25h\n"
+	want := "Error: disk full\ndocs and café\nworking\n"
+
+	// Every chunk size, so sequences are split at every possible point
+	for size := 1; size <= len(styled); size++ {
+		var buf bytes.Buffer
+		w := NewStrippingWriter(&buf)
+		for i := 0; i < len(styled); i += size {
+			chunk := styled[i:min(i+size, len(styled))]
+			if n, err := w.Write([]byte(chunk)); err != nil || n != len(chunk) {
+				t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("Flush() error = %v", err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("Chunk size %d: got %q, want %q", size, got, want)
+		}
+	}
+}
+
+func TestStrippingWriterFlush(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewStrippingWriter(&buf)
+
+	// An unfinished sequence is held back, then passed through by Flush
+	w.WriteString("done\x1b[3")
+	if got := buf.String(); got != "done" {
+		t.Errorf("Before flush: got %q, want %q", got, "done")
+	}
+	w.Flush()
+	if got := buf.String(); got != "done\x1b[3" {
+		t.Errorf("After flush: got %q, want %q", got, "done\x1b[3")
+	}
+
+	// Text after an unrecognized escape is not held back
+	buf.Reset()
+	w.WriteString("a\x1b(Bb")
+	if got := buf.String(); got != "a\x1b(Bb" {
+		t.Errorf("Unrecognized escape: got %q", got)
+	}
+}
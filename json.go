@@ -0,0 +1,198 @@
+package rich
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+)
+
+// PrettyJSON is a renderable that pretty-prints JSON with syntax coloring.
+// Object keys, strings, numbers, booleans, and null each get a distinct
+// style, and nested objects and arrays are indented. Key order from the
+// source is preserved.
+//
+// Create one with JSON (from a Go value) or JSONString (from JSON text).
+type PrettyJSON struct {
+	data   []byte // Raw JSON to format
+	err    error  // Encoding error from JSON, shown instead of the data
+	indent int    // Spaces per nesting level
+}
+
+// Styles for each kind of JSON token.
+var (
+	jsonKeyStyle    = NewStyle().Foreground(Blue).Bold()
+	jsonStringStyle = NewStyle().Foreground(Green)
+	jsonNumberStyle = NewStyle().Foreground(Cyan)
+	jsonBoolStyle   = NewStyle().Foreground(Yellow).Italic()
+	jsonNullStyle   = NewStyle().Foreground(Magenta).Italic()
+	jsonErrorStyle  = NewStyle().Foreground(Red)
+)
+
+// JSON returns a renderable that pretty-prints v as colorized JSON.
+// The value is encoded with encoding/json, so struct tags and custom
+// marshalers are honored. If v can't be encoded, the error is rendered
+// in red instead.
+//
+// Example:
+//
+//	console.Renderln(rich.JSON(map[string]any{
+//		"name":    "go-rich",
+//		"stars":   42,
+//		"private": false,
+//	}))
+func JSON(v any) *PrettyJSON {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(v)
+	return &PrettyJSON{data: buf.Bytes(), err: err, indent: 2}
+}
+
+// JSONString returns a renderable that pretty-prints the JSON text s.
+// Unlike JSON, the original key order and number formatting are kept.
+// If s holds several top-level values, such as newline-delimited JSON,
+// each one is formatted starting on its own line.
+// If s is not valid JSON, it is rendered unchanged and unstyled.
+//
+// Example:
+//
+//	body, _ := io.ReadAll(resp.Body)
+//	console.Renderln(rich.JSONString(string(body)))
+func JSONString(s string) *PrettyJSON {
+	return &PrettyJSON{data: []byte(s), indent: 2}
+}
+
+// Indent sets the number of spaces per nesting level.
+// Negative values are treated as 0. Default is 2.
+//
+// Example:
+//
+//	rich.JSON(config).Indent(4)
+func (j *PrettyJSON) Indent(indent int) *PrettyJSON {
+	j.indent = max(indent, 0)
+	return j
+}
+
+// Render implements Renderable.
+// The output is not wrapped to width; long strings stay on one line.
+func (j *PrettyJSON) Render(console *Console, width int) Segments {
+	if j.err != nil {
+		return Segments{{Text: "invalid JSON: " + j.err.Error(), Style: jsonErrorStyle}}
+	}
+
+	segments, err := j.format()
+	if err != nil {
+		return Segments{{Text: strings.TrimSpace(string(j.data))}}
+	}
+	return segments
+}
+
+// jsonFrame tracks an open object or array while formatting.
+type jsonFrame struct {
+	object    bool // Whether this is an object (otherwise an array)
+	count     int  // Number of members written so far
+	expectKey bool // Whether the next token in an object is a key
+}
+
+// format tokenizes the JSON data and emits styled, indented segments.
+func (j *PrettyJSON) format() (Segments, error) {
+	dec := json.NewDecoder(bytes.NewReader(j.data))
+	dec.UseNumber()
+
+	var segments Segments
+	var stack []jsonFrame
+
+	emit := func(text string, style Style) {
+		segments = append(segments, Segment{Text: text, Style: style})
+	}
+	newline := func(depth int) {
+		emit("\n"+strings.Repeat(" ", depth*j.indent), NewStyle())
+	}
+
+	// valueDone updates the enclosing container after a complete value
+	valueDone := func() {
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			top.count++
+			top.expectKey = top.object
+		}
+	}
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Closing delimiters end the current container
+		if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+			frame := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if frame.count > 0 {
+				newline(len(stack))
+			}
+			emit(delim.String(), NewStyle())
+			valueDone()
+			continue
+		}
+
+		// Separate members and place them on their own lines
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.count > 0 && (!top.object || top.expectKey) {
+				emit(",", NewStyle())
+			}
+			if top.expectKey {
+				newline(len(stack))
+				emit(quoteJSON(tok.(string)), jsonKeyStyle)
+				emit(": ", NewStyle())
+				top.expectKey = false
+				continue
+			}
+			if !top.object {
+				newline(len(stack))
+			}
+		} else if len(segments) > 0 {
+			// Another top-level value (e.g. the next line of NDJSON)
+			newline(0)
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			emit(v.String(), NewStyle())
+			stack = append(stack, jsonFrame{object: v == '{', expectKey: v == '{'})
+			continue
+		case string:
+			emit(quoteJSON(v), jsonStringStyle)
+		case json.Number:
+			emit(v.String(), jsonNumberStyle)
+		case bool:
+			if v {
+				emit("true", jsonBoolStyle)
+			} else {
+				emit("false", jsonBoolStyle)
+			}
+		case nil:
+			emit("null", jsonNullStyle)
+		}
+		valueDone()
+	}
+
+	if len(stack) > 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return segments, nil
+}
+
+// quoteJSON returns s as a quoted JSON string, without HTML escaping.
+func quoteJSON(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // Encoding a string can't fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,102 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJSONString(t *testing.T) {
+	console := NewConsole(nil)
+
+	input := `{"name":"rich","version":1.5,"tags":["cli","<tui>"],"meta":{"ok":true,"off":false,"none":null},"empty":{},"list":[]}`
+	segments := JSONString(input).Render(console, 80)
+
+	want := `{
+  "name": "rich",
+  "version": 1.5,
+  "tags": [
+    "cli",
+    "<tui>"
+  ],
+  "meta": {
+    "ok": true,
+    "off": false,
+    "none": null
+  },
+  "empty": {},
+  "list": []
+}`
+	if got := segments.String(); got != want {
+		t.Errorf("JSONString output =\n%s\nwant\n%s", got, want)
+	}
+
+	// Each token type gets its own style
+	styles := map[string]Style{
+		`"name"`: jsonKeyStyle,
+		`"rich"`: jsonStringStyle,
+		"1.5":    jsonNumberStyle,
+		"true":   jsonBoolStyle,
+		"false":  jsonBoolStyle,
+		"null":   jsonNullStyle,
+	}
+	for text, want := range styles {
+		found := false
+		for _, seg := range segments {
+			if seg.Text == text {
+				found = true
+				if !seg.Style.Equal(want) {
+					t.Errorf("Token %s has style %+v, want %+v", text, seg.Style, want)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Token %s not found in output", text)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	console := NewConsole(nil)
+
+	v := struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}{"a & b", 3}
+
+	want := "{\n    \"name\": \"a & b\",\n    \"count\": 3\n}"
+	if got := JSON(v).Indent(4).Render(console, 80).String(); got != want {
+		t.Errorf("JSON output = %q, want %q", got, want)
+	}
+}
+
+func TestJSONMultipleValues(t *testing.T) {
+	console := NewConsole(nil)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1 2", "1\n2"},
+		{"{\"a\":1}\n{\"a\":2}\n", "{\n  \"a\": 1\n}\n{\n  \"a\": 2\n}"},
+		{`"x" [] null`, "\"x\"\n[]\nnull"},
+	}
+
+	for _, tt := range tests {
+		if got := JSONString(tt.input).Render(console, 80).String(); got != tt.want {
+			t.Errorf("JSONString(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	console := NewConsole(nil)
+
+	if got := JSONString(`{"a": `).Render(console, 80).String(); got != `{"a":` {
+		t.Errorf("Expected invalid JSON to be rendered unchanged, got %q", got)
+	}
+
+	got := JSON(make(chan int)).Render(console, 80)
+	if !strings.HasPrefix(got.String(), "invalid JSON: ") {
+		t.Errorf("Expected encoding error, got %q", got.String())
+	}
+}
@@ -0,0 +1,208 @@
+package rich
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ListMode selects how the items of a List are marked.
+type ListMode int
+
+const (
+	// ListBulleted marks each item with a bullet glyph ("•" by default).
+	ListBulleted ListMode = iota
+
+	// ListNumbered numbers the items, starting at 1 by default.
+	ListNumbered
+)
+
+// List is a renderable that shows items as a bulleted or numbered list.
+// Each item is rendered with a hanging indent: when an item wraps or spans
+// several lines, the continuation lines line up under the item's text rather
+// than under its marker.
+//
+// Create one with NewList.
+type List struct {
+	items       []Renderable // Items, top to bottom
+	mode        ListMode     // Bulleted or numbered
+	bullet      string       // Glyph for bulleted items
+	start       int          // Number of the first item in numbered mode
+	markerStyle Style        // Style of the bullets or numbers
+}
+
+// NewList creates a list in the given mode. Items may be strings, which are
+// word-wrapped to fit, or Renderables such as panels and tables; any other
+// value is formatted with fmt.Sprint. Nil items are skipped.
+//
+// Example:
+//
+//	console.Renderln(rich.NewList(rich.ListBulleted,
+//		"Run the migrations before deploying",
+//		"Restart the workers once the deploy has finished, so they pick up the new configuration",
+//	))
+//
+//	steps := rich.NewList(rich.ListNumbered, "Clone", "Build", "Test").
+//		MarkerStyle(rich.NewStyle().Bold())
+func NewList(mode ListMode, items ...interface{}) *List {
+	l := &List{
+		mode:        mode,
+		bullet:      "•",
+		start:       1,
+		markerStyle: NewStyle().Dim(),
+	}
+	return l.Add(items...)
+}
+
+// Add appends items to the end of the list (see NewList for accepted types).
+// Returns the list for method chaining.
+//
+// Example:
+//
+//	list := rich.NewList(rich.ListBulleted)
+//	for _, warning := range warnings {
+//		list.Add(warning)
+//	}
+func (l *List) Add(items ...interface{}) *List {
+	for _, item := range items {
+		switch v := item.(type) {
+		case nil:
+		case string:
+			l.items = append(l.items, &wrappedText{segments: Segments{{Text: v}}})
+		case Renderable:
+			l.items = append(l.items, v)
+		default:
+			l.items = append(l.items, &wrappedText{segments: Segments{{Text: fmt.Sprint(v)}}})
+		}
+	}
+	return l
+}
+
+// Bullet sets the glyph used in bulleted mode. Default is "•".
+//
+// Example:
+//
+//	list := rich.NewList(rich.ListBulleted, "one", "two").Bullet("-")
+func (l *List) Bullet(glyph string) *List {
+	l.bullet = glyph
+	return l
+}
+
+// Start sets the number of the first item in numbered mode. Default is 1.
+//
+// Example:
+//
+//	// Continue a list from a previous page
+//	list := rich.NewList(rich.ListNumbered, items...).Start(11)
+func (l *List) Start(n int) *List {
+	l.start = n
+	return l
+}
+
+// MarkerStyle sets the style of the bullets or numbers. Default is dim.
+//
+// Example:
+//
+//	list := rich.NewList(rich.ListNumbered, steps...).
+//		MarkerStyle(rich.NewStyle().Foreground(rich.Cyan))
+func (l *List) MarkerStyle(style Style) *List {
+	l.markerStyle = style
+	return l
+}
+
+// markers returns the marker text for each item, all padded to the same
+// width, followed by a space. Numbers are right-aligned so their dots line up.
+func (l *List) markers() []string {
+	markers := make([]string, len(l.items))
+	if l.mode != ListNumbered {
+		for i := range markers {
+			markers[i] = l.bullet + " "
+		}
+		return markers
+	}
+
+	width := 0
+	for i := range markers {
+		markers[i] = strconv.Itoa(l.start+i) + "."
+		width = max(width, DisplayWidth(markers[i]))
+	}
+	for i, marker := range markers {
+		markers[i] = strings.Repeat(" ", width-DisplayWidth(marker)) + marker + " "
+	}
+	return markers
+}
+
+// Render implements Renderable.
+// Each item is rendered at the width left after its marker; its first line
+// follows the marker and the rest are indented by the marker's width.
+func (l *List) Render(console *Console, width int) Segments {
+	if len(l.items) == 0 {
+		return nil
+	}
+
+	markers := l.markers()
+	indentWidth := DisplayWidth(markers[0])
+	indent := Segment{Text: strings.Repeat(" ", indentWidth)}
+
+	var result Segments
+	for i, item := range l.items {
+		lines := blockLines(item.Render(console, max(width-indentWidth, 1)))
+		for j, line := range lines {
+			if i > 0 || j > 0 {
+				result = append(result, Segment{Text: "\n"})
+			}
+			if j == 0 {
+				result = append(result, Segment{Text: markers[i], Style: l.markerStyle})
+			} else {
+				result = append(result, indent)
+			}
+			result = append(result, line...)
+		}
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// The list needs the marker width plus the width of its widest item.
+func (l *List) Measure(console *Console, maxWidth int) Measurement {
+	if len(l.items) == 0 {
+		return Measurement{}
+	}
+
+	indentWidth := DisplayWidth(l.markers()[0])
+	var m Measurement
+	for _, item := range l.items {
+		m = m.Max(MeasureRenderable(console, item, max(maxWidth-indentWidth, 1)))
+	}
+	return m.Add(Measurement{Minimum: indentWidth, Maximum: indentWidth})
+}
+
+// wrappedText is a renderable for plain text that word-wraps to the render
+// width (see Segments.Wrap).
+type wrappedText struct {
+	segments Segments // Text to wrap
+}
+
+// Render implements Renderable.
+func (w *wrappedText) Render(console *Console, width int) Segments {
+	var result Segments
+	for i, line := range w.segments.Wrap(width) {
+		if i > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+		result = append(result, line...)
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// The text needs at least its longest word, and at most its longest line.
+func (w *wrappedText) Measure(console *Console, maxWidth int) Measurement {
+	var m Measurement
+	for _, line := range w.segments.SplitLines() {
+		text := line.String()
+		m.Minimum = max(m.Minimum, longestWord(text))
+		m.Maximum = max(m.Maximum, DisplayWidth(text))
+	}
+	return m.Clamp(0, maxWidth)
+}
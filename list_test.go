@@ -0,0 +1,58 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestListBulleted(t *testing.T) {
+	console := NewConsole(nil)
+
+	list := NewList(ListBulleted,
+		"Short item",
+		"A longer item that needs to wrap onto more lines",
+	)
+	got := strings.Split(list.Render(console, 20).String(), "\n")
+
+	// Continuation lines line up under the text, not the bullet
+	want := []string{
+		"• Short item",
+		"• A longer item that",
+		"  needs to wrap onto",
+		"  more lines",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("List output = %q, want %q", got, want)
+	}
+
+	if m := list.Measure(console, 80); m.Minimum != 8 || m.Maximum != 50 {
+		t.Errorf("Expected measurement {8 50}, got %+v", m)
+	}
+
+	// Custom glyph and marker style
+	styled := NewList(ListBulleted, "x").Bullet("->").MarkerStyle(NewStyle().Bold())
+	segments := styled.Render(console, 20)
+	if segments.String() != "-> x" || !segments[0].Style.IsBold() {
+		t.Errorf("Expected bold custom bullet, got %q (%s)", segments.String(), segments[0].Style)
+	}
+}
+
+func TestListNumbered(t *testing.T) {
+	console := NewConsole(nil)
+
+	list := NewList(ListNumbered).Start(9)
+	list.Add("Ninth", "Tenth item wraps here", NewRenderableString("line one\nline two", NewStyle()))
+	got := strings.Split(list.Render(console, 16).String(), "\n")
+
+	// Numbers are right-aligned, and every item is indented past the widest one
+	want := []string{
+		" 9. Ninth",
+		"10. Tenth item",
+		"    wraps here",
+		"11. line one",
+		"    line two",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("List output = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,175 @@
+package rich
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/eberle1080/go-rich/internal/ansi"
+)
+
+// Live displays a renderable that is redrawn in place. Each refresh moves
+// the cursor back up over the previous output and draws the new state over
+// it, the same way the progress package keeps its bars on screen, so a
+// changing table or panel can be shown as a live dashboard instead of being
+// printed again on every update.
+//
+// Live does not refresh on its own: call Update whenever the content
+// changes. Nothing else should write to the console while a Live display is
+// active, or the line tracking will be thrown off. The display is written
+// straight to the console's writer, so it is not captured by Record.
+//
+// Create one with NewLive.
+type Live struct {
+	console    *Console   // Console the display is written to
+	renderable Renderable // Content being displayed
+	transient  bool       // Whether Stop removes the display
+
+	mu            sync.Mutex // Serializes refreshes
+	started       bool       // Whether Start has been called (and Stop has not)
+	lastLineCount int        // Lines drawn by the previous refresh
+}
+
+// NewLive creates a live display of renderable on console.
+// Nothing is drawn until Start or Update is called.
+//
+// Example:
+//
+//	live := rich.NewLive(console, buildTable(stats))
+//	live.Start()
+//	for range time.Tick(time.Second) {
+//		live.UpdateRenderable(buildTable(stats))
+//	}
+func NewLive(console *Console, renderable Renderable) *Live {
+	return &Live{
+		console:    console,
+		renderable: renderable,
+	}
+}
+
+// Transient sets whether Stop removes the display.
+// By default the final state is left on screen.
+//
+// Example:
+//
+//	live := rich.NewLive(console, status).Transient(true)
+func (l *Live) Transient(transient bool) *Live {
+	l.transient = transient
+	return l
+}
+
+// Start hides the cursor and draws the renderable.
+// Calling Start on a display that is already started just redraws it.
+func (l *Live) Start() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	prefix := ""
+	if !l.started {
+		prefix = ansi.HideCursor
+		l.started = true
+	}
+	l.refreshLocked(prefix)
+}
+
+// Update redraws the renderable in place, replacing the previous output.
+// Use this after changing the state the renderable draws from.
+//
+// Example:
+//
+//	stats.Requests++
+//	live.Update()
+func (l *Live) Update() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refreshLocked("")
+}
+
+// UpdateRenderable replaces the displayed renderable and redraws it in place.
+//
+// Example:
+//
+//	live.UpdateRenderable(table.New().
+//		Headers("Service", "Status").
+//		Row("api", status))
+func (l *Live) UpdateRenderable(renderable Renderable) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.renderable = renderable
+	l.refreshLocked("")
+}
+
+// Stop ends the live display and shows the cursor again. The final state is
+// left on screen, or removed if the display is transient. Later output is
+// written below it; a subsequent Update starts a new display there too.
+func (l *Live) Stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.started {
+		return
+	}
+	l.started = false
+
+	var b strings.Builder
+	if l.transient {
+		l.clearLocked(&b)
+	}
+	b.WriteString(ansi.ShowCursor)
+	l.writeLocked(b.String())
+
+	l.lastLineCount = 0
+}
+
+// refreshLocked draws the renderable over the previous output, after
+// writing prefix. The caller must hold l.mu.
+func (l *Live) refreshLocked(prefix string) {
+	c := l.console
+
+	var lines []Segments
+	if l.renderable != nil {
+		segments := l.renderable.Render(c, c.Width())
+		if c.tabSize > 0 {
+			segments = segments.ExpandTabs(c.tabSize)
+		}
+		lines = segments.SplitLines()
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteString(ansi.CursorUpN(l.lastLineCount))
+	for _, line := range lines {
+		b.WriteString(ansi.LineStart)
+		b.WriteString(c.formatSegments(line))
+		b.WriteString("\n")
+	}
+
+	// Clear the lines left over when the new output is shorter
+	b.WriteString(ansi.ClearLines(l.lastLineCount - len(lines)))
+
+	l.writeLocked(b.String())
+	l.lastLineCount = len(lines)
+}
+
+// clearLocked appends the sequences that erase the current output to b and
+// leaves the cursor where it started. The caller must hold l.mu.
+func (l *Live) clearLocked(b *strings.Builder) {
+	if l.lastLineCount == 0 {
+		return
+	}
+	b.WriteString(ansi.CursorUpN(l.lastLineCount))
+	b.WriteString(ansi.ClearLines(l.lastLineCount))
+	l.lastLineCount = 0
+}
+
+// writeLocked writes s to the console in a single call, holding the
+// console's lock so other goroutines' output can't land in the middle.
+// It writes straight to the console's writer, bypassing recording: the
+// in-place redraws are not kept by Record or included in exports.
+func (l *Live) writeLocked(s string) {
+	l.console.mu.Lock()
+	defer l.console.mu.Unlock()
+
+	l.console.writer.Write([]byte(s))
+}
@@ -0,0 +1,89 @@
+package rich
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/eberle1080/go-rich/internal/ansi"
+)
+
+func TestLiveUpdate(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	rows := []string{"api    up", "web    up", "worker up"}
+	dashboard := func() Renderable {
+		return NewRenderableString(strings.Join(rows, "\n"), NewStyle())
+	}
+
+	live := NewLive(console, dashboard())
+	live.Start()
+	if !strings.HasPrefix(buf.String(), ansi.HideCursor) {
+		t.Errorf("Expected Start to hide the cursor, got %q", buf.String())
+	}
+	if live.lastLineCount != 3 {
+		t.Errorf("Expected lastLineCount=3, got %d", live.lastLineCount)
+	}
+
+	// Each update moves back over the previous frame instead of stacking
+	for i := 0; i < 2; i++ {
+		rows[1] = "web    down"
+		buf.Reset()
+		live.UpdateRenderable(dashboard())
+
+		out := buf.String()
+		if !strings.HasPrefix(out, ansi.CursorUpN(3)) {
+			t.Errorf("Update %d: expected cursor up 3 lines, got %q", i, out)
+		}
+		if strings.Count(out, "\n") != 3 || !strings.Contains(out, "web    down") {
+			t.Errorf("Update %d: expected the 3 lines redrawn, got %q", i, out)
+		}
+		if live.lastLineCount != 3 {
+			t.Errorf("Update %d: expected lastLineCount=3, got %d", i, live.lastLineCount)
+		}
+	}
+
+	// A shorter frame clears the leftover line and returns to below the output
+	rows = rows[:2]
+	buf.Reset()
+	live.UpdateRenderable(dashboard())
+	want := ansi.CursorUpN(3) +
+		ansi.LineStart + "api    up\n" +
+		ansi.LineStart + "web    down\n" +
+		ansi.LineStart + "\n" + ansi.CursorUpN(1)
+	if got := buf.String(); got != want {
+		t.Errorf("Shorter frame = %q, want %q", got, want)
+	}
+	if live.lastLineCount != 2 {
+		t.Errorf("Expected lastLineCount=2, got %d", live.lastLineCount)
+	}
+
+	// Stop leaves the final state and shows the cursor
+	buf.Reset()
+	live.Stop()
+	if got := buf.String(); got != ansi.ShowCursor {
+		t.Errorf("Stop = %q, want %q", got, ansi.ShowCursor)
+	}
+	live.Stop()
+	if buf.String() != ansi.ShowCursor {
+		t.Errorf("Expected second Stop to be a no-op, got %q", buf.String())
+	}
+}
+
+func TestLiveTransient(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	live := NewLive(console, NewRenderableString("one\ntwo", NewStyle())).Transient(true)
+	live.Start()
+	buf.Reset()
+	live.Stop()
+
+	want := ansi.CursorUpN(2) + strings.Repeat(ansi.LineStart+"\n", 2) + ansi.CursorUpN(2) + ansi.ShowCursor
+	if got := buf.String(); got != want {
+		t.Errorf("Transient stop = %q, want %q", got, want)
+	}
+}
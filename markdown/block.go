@@ -0,0 +1,153 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+)
+
+// blockKind identifies the type of a block-level element.
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota // Wrapped text
+	blockHeading                    // # Heading
+	blockList                       // Bullet or numbered list
+	blockCode                       // Fenced code block
+	blockRule                       // Horizontal rule
+)
+
+// block is a block-level Markdown element.
+type block struct {
+	kind  blockKind
+	level int        // Heading level (1-6)
+	lang  string     // Code block language from the opening fence
+	lines []string   // Heading text, paragraph lines, or code lines
+	items []listItem // List items
+}
+
+// listItem is a single entry in a bullet or numbered list.
+type listItem struct {
+	depth  int    // Nesting level (0 = top level)
+	number int    // Item number for numbered lists (0 = bullet)
+	text   string // Item text, with inline markup
+}
+
+// parseBlocks splits Markdown source into block-level elements.
+// Blocks are separated by blank lines, or start when a line begins a
+// different kind of block (e.g. a heading directly after a paragraph).
+func parseBlocks(source string) []block {
+	var blocks []block
+	var current *block
+
+	finish := func() {
+		if current != nil {
+			blocks = append(blocks, *current)
+			current = nil
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			finish()
+
+		case strings.HasPrefix(trimmed, "```"):
+			finish()
+			code := block{kind: blockCode, lang: strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))}
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code.lines = append(code.lines, lines[i])
+			}
+			blocks = append(blocks, code)
+
+		case isRule(trimmed):
+			finish()
+			blocks = append(blocks, block{kind: blockRule})
+
+		case headingLevel(trimmed) > 0:
+			finish()
+			level := headingLevel(trimmed)
+			text := strings.TrimSpace(strings.TrimRight(trimmed[level:], "#"))
+			blocks = append(blocks, block{kind: blockHeading, level: level, lines: []string{text}})
+
+		default:
+			if item, ok := parseListItem(line); ok {
+				if current == nil || current.kind != blockList {
+					finish()
+					current = &block{kind: blockList}
+				}
+				current.items = append(current.items, item)
+				continue
+			}
+
+			if current != nil && current.kind == blockList {
+				// Continuation of the previous list item
+				last := &current.items[len(current.items)-1]
+				last.text += " " + trimmed
+				continue
+			}
+
+			if current == nil {
+				current = &block{kind: blockParagraph}
+			}
+			current.lines = append(current.lines, trimmed)
+		}
+	}
+	finish()
+
+	return blocks
+}
+
+// headingLevel returns the level of an ATX heading line ("## Title" is 2),
+// or 0 if the line isn't a heading.
+func headingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0
+	}
+	if level < len(line) && line[level] != ' ' {
+		return 0
+	}
+	return level
+}
+
+// isRule reports whether a line is a horizontal rule: three or more of the
+// same character from -, *, or _, optionally separated by spaces.
+func isRule(line string) bool {
+	compact := strings.ReplaceAll(line, " ", "")
+	if len(compact) < 3 {
+		return false
+	}
+	c := compact[0]
+	if c != '-' && c != '*' && c != '_' {
+		return false
+	}
+	return strings.Count(compact, string(c)) == len(compact)
+}
+
+// parseListItem parses a bullet ("- item") or numbered ("1. item") list line.
+// Every two spaces of indentation add a nesting level.
+func parseListItem(line string) (listItem, bool) {
+	content := strings.TrimLeft(line, " ")
+	depth := (len(line) - len(content)) / 2
+
+	for _, bullet := range []string{"- ", "* ", "+ "} {
+		if strings.HasPrefix(content, bullet) {
+			return listItem{depth: depth, text: strings.TrimSpace(content[2:])}, true
+		}
+	}
+
+	if dot := strings.Index(content, ". "); dot > 0 {
+		if n, err := strconv.Atoi(content[:dot]); err == nil && n >= 0 {
+			return listItem{depth: depth, number: max(n, 1), text: strings.TrimSpace(content[dot+2:])}, true
+		}
+	}
+
+	return listItem{}, false
+}
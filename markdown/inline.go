@@ -0,0 +1,102 @@
+package markdown
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eberle1080/go-rich"
+)
+
+// parseInline converts inline Markdown (emphasis and code spans) into
+// styled segments layered on top of base. Markers without a matching
+// closing marker are kept as literal text, and a backslash escapes the
+// next character.
+func parseInline(text string, base, code rich.Style) rich.Segments {
+	var segments rich.Segments
+	var current strings.Builder
+	bold, italic := false, false
+
+	style := func() rich.Style {
+		s := base
+		if bold {
+			s = s.Bold()
+		}
+		if italic {
+			s = s.Italic()
+		}
+		return s
+	}
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, rich.Segment{Text: current.String(), Style: style()})
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text) && strings.IndexByte("\\`*_#-+.", text[i+1]) >= 0:
+			current.WriteByte(text[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			if end := strings.IndexByte(text[i+1:], '`'); end >= 0 {
+				flush()
+				segments = append(segments, rich.Segment{
+					Text:  text[i+1 : i+1+end],
+					Style: base.Combine(code),
+				})
+				i += end + 2
+				continue
+			}
+
+		case c == '*' || c == '_':
+			marker := string(c)
+			if strings.HasPrefix(text[i:], marker+marker) {
+				marker += marker
+			}
+			if emphasisAllowed(text, i, len(marker)) {
+				active := &italic
+				if len(marker) == 2 {
+					active = &bold
+				}
+				// Only open emphasis that is closed later in the text
+				if *active || strings.Contains(text[i+len(marker):], marker) {
+					flush()
+					*active = !*active
+					i += len(marker)
+					continue
+				}
+			}
+			current.WriteString(marker)
+			i += len(marker)
+			continue
+		}
+
+		current.WriteByte(c)
+		i++
+	}
+	flush()
+
+	return segments
+}
+
+// emphasisAllowed reports whether the marker at text[i:i+n] can open or
+// close emphasis. Underscores inside words (snake_case) are literal.
+func emphasisAllowed(text string, i, n int) bool {
+	if text[i] != '_' {
+		return true
+	}
+	before, _ := utf8.DecodeLastRuneInString(text[:i])
+	after, _ := utf8.DecodeRuneInString(text[i+n:])
+	return !(isWordRune(before) && isWordRune(after))
+}
+
+// isWordRune reports whether r is a letter or digit.
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
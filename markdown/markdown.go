@@ -0,0 +1,196 @@
+// Package markdown renders a useful subset of Markdown to the terminal.
+//
+// The supported syntax covers what most CLI help text, changelogs, and
+// READMEs need:
+//   - Headings (# through ######), rendered bold, with a rule under level 1
+//   - Paragraphs, word-wrapped to the available width
+//   - Inline **bold**, *italic*, and `code` spans (also __bold__ and _italic_)
+//   - Bullet lists (-, *, +) and numbered lists (1.), including nesting
+//   - Fenced code blocks (```), rendered in a bordered panel and highlighted
+//     when the fence names a language the syntax package supports
+//   - Horizontal rules (---, ***, ___)
+//
+// Anything else (links, tables, block quotes, HTML) is rendered as plain text.
+//
+// # Basic Usage
+//
+//	md := markdown.New("# Release notes\n\n- **Fast** startup\n- Fewer `allocs`")
+//	console.Renderln(md)
+//
+// Markdown values are Renderables, so they compose with panels, groups,
+// and other layout renderables.
+package markdown
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/eberle1080/go-rich"
+	"github.com/eberle1080/go-rich/panel"
+	"github.com/eberle1080/go-rich/syntax"
+	"github.com/eberle1080/go-rich/table"
+)
+
+// Markdown is a renderable that displays Markdown source as styled text.
+// Create one with New.
+type Markdown struct {
+	source string // The Markdown source text
+
+	headingStyle rich.Style // Style for headings (levels 2+ add to this)
+	codeStyle    rich.Style // Style for inline code spans and code blocks
+	bulletStyle  rich.Style // Style for list bullets and numbers
+	ruleStyle    rich.Style // Style for horizontal rules and heading rules
+}
+
+// New creates a Markdown renderable from source.
+// Headings are bold, code is cyan, and rules and bullets are dim by default.
+//
+// Example:
+//
+//	console.Renderln(markdown.New(readme))
+func New(source string) *Markdown {
+	return &Markdown{
+		source:       source,
+		headingStyle: rich.NewStyle().Bold(),
+		codeStyle:    rich.NewStyle().Foreground(rich.Cyan),
+		bulletStyle:  rich.NewStyle().Bold(),
+		ruleStyle:    rich.NewStyle().Dim(),
+	}
+}
+
+// HeadingStyle sets the base style for headings.
+// Level 2 headings are additionally underlined.
+// Default is bold.
+//
+// Example:
+//
+//	md.HeadingStyle(rich.NewStyle().Bold().Foreground(rich.Magenta))
+func (m *Markdown) HeadingStyle(style rich.Style) *Markdown {
+	m.headingStyle = style
+	return m
+}
+
+// CodeStyle sets the style for inline code spans and for fenced code blocks
+// without a supported language (those are syntax highlighted instead).
+// Default is a cyan foreground.
+//
+// Example:
+//
+//	md.CodeStyle(rich.NewStyle().Foreground(rich.Yellow))
+func (m *Markdown) CodeStyle(style rich.Style) *Markdown {
+	m.codeStyle = style
+	return m
+}
+
+// Render implements rich.Renderable.
+// Blocks are separated by a blank line; the output has no trailing newline.
+func (m *Markdown) Render(console *rich.Console, width int) rich.Segments {
+	if width < 1 {
+		width = 1
+	}
+
+	var result rich.Segments
+	for i, block := range parseBlocks(m.source) {
+		if i > 0 {
+			result = append(result, rich.Segment{Text: "\n\n"})
+		}
+		result = append(result, joinLines(m.renderBlock(console, block, width))...)
+	}
+	return result
+}
+
+// renderBlock renders a single block into lines.
+func (m *Markdown) renderBlock(console *rich.Console, b block, width int) []rich.Segments {
+	switch b.kind {
+	case blockHeading:
+		return m.renderHeading(b, width)
+
+	case blockRule:
+		return []rich.Segments{{{Text: strings.Repeat("─", width), Style: m.ruleStyle}}}
+
+	case blockCode:
+		var code rich.Renderable = &codeBlock{lines: b.lines, style: m.codeStyle}
+		if syntax.Supported(b.lang) {
+			code = syntax.New(strings.Join(b.lines, "\n"), b.lang)
+		}
+		p := panel.New(code).Box(table.BoxRounded).BorderStyle(m.ruleStyle)
+		return p.Render(console, width).SplitLines()
+
+	case blockList:
+		var lines []rich.Segments
+		for _, item := range b.items {
+			lines = append(lines, m.renderListItem(item, width)...)
+		}
+		return lines
+
+	default:
+		text := strings.Join(b.lines, " ")
+		return parseInline(text, rich.NewStyle(), m.codeStyle).Wrap(width)
+	}
+}
+
+// renderHeading renders a heading. Level 1 headings get a full-width rule
+// beneath them and level 2 headings are underlined.
+func (m *Markdown) renderHeading(b block, width int) []rich.Segments {
+	style := m.headingStyle
+	if b.level == 2 {
+		style = style.Underline()
+	}
+
+	lines := parseInline(b.lines[0], style, m.codeStyle).Wrap(width)
+	if b.level == 1 {
+		lines = append(lines, rich.Segments{{Text: strings.Repeat("━", width), Style: m.ruleStyle}})
+	}
+	return lines
+}
+
+// renderListItem renders a list item with its bullet or number, wrapping the
+// text with a hanging indent so continuation lines align with the text.
+func (m *Markdown) renderListItem(item listItem, width int) []rich.Segments {
+	marker := "•"
+	if item.number > 0 {
+		marker = strconv.Itoa(item.number) + "."
+	}
+
+	indent := strings.Repeat("  ", item.depth)
+	prefixWidth := rich.DisplayWidth(indent+marker) + 1
+	wrapped := parseInline(item.text, rich.NewStyle(), m.codeStyle).Wrap(width - prefixWidth)
+
+	lines := make([]rich.Segments, len(wrapped))
+	for i, line := range wrapped {
+		if i == 0 {
+			lines[i] = append(rich.Segments{
+				{Text: indent},
+				{Text: marker, Style: m.bulletStyle},
+				{Text: " "},
+			}, line...)
+		} else {
+			lines[i] = append(rich.Segments{{Text: strings.Repeat(" ", prefixWidth)}}, line...)
+		}
+	}
+	return lines
+}
+
+// codeBlock renders the lines of a fenced code block verbatim, without
+// wrapping, so the enclosing panel truncates lines that are too long.
+type codeBlock struct {
+	lines []string
+	style rich.Style
+}
+
+// Render implements rich.Renderable.
+func (c *codeBlock) Render(console *rich.Console, width int) rich.Segments {
+	return rich.Segments{{Text: strings.Join(c.lines, "\n"), Style: c.style}}
+}
+
+// joinLines joins lines of segments with newline segments.
+func joinLines(lines []rich.Segments) rich.Segments {
+	var result rich.Segments
+	for i, line := range lines {
+		if i > 0 {
+			result = append(result, rich.Segment{Text: "\n"})
+		}
+		result = append(result, line...)
+	}
+	return result
+}
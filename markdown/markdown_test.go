@@ -0,0 +1,136 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eberle1080/go-rich"
+	"github.com/eberle1080/go-rich/syntax"
+)
+
+// findSegment returns the first segment with the given text.
+func findSegment(segments rich.Segments, text string) (rich.Segment, bool) {
+	for _, seg := range segments {
+		if seg.Text == text {
+			return seg, true
+		}
+	}
+	return rich.Segment{}, false
+}
+
+func TestMarkdownHeading(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	segments := New("# Title").Render(console, 10)
+
+	if got := segments.String(); got != "Title\n━━━━━━━━━━" {
+		t.Errorf("Heading output = %q, want %q", got, "Title\n━━━━━━━━━━")
+	}
+
+	title, ok := findSegment(segments, "Title")
+	if !ok {
+		t.Fatal("Title segment not found")
+	}
+	if !title.Style.Equal(rich.NewStyle().Bold()) {
+		t.Errorf("Expected bold title, got %+v", title.Style)
+	}
+
+	sub, _ := findSegment(New("## Sub").Render(console, 10), "Sub")
+	if !sub.Style.Equal(rich.NewStyle().Bold().Underline()) {
+		t.Errorf("Expected bold underlined level 2 heading, got %+v", sub.Style)
+	}
+}
+
+func TestMarkdownList(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	source := "- item\n- **bold** item\n  - nested\n\n1. first\n2. second"
+	got := New(source).Render(console, 40).String()
+
+	want := "• item\n• bold item\n  • nested\n\n1. first\n2. second"
+	if got != want {
+		t.Errorf("List output = %q, want %q", got, want)
+	}
+
+	bullet, ok := findSegment(New("- item").Render(console, 40), "•")
+	if !ok || !bullet.Style.Equal(rich.NewStyle().Bold()) {
+		t.Errorf("Expected bold bullet, got %+v", bullet)
+	}
+}
+
+func TestMarkdownListWrapping(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	got := New("- one two three four").Render(console, 10).String()
+	want := "• one two\n  three\n  four"
+	if got != want {
+		t.Errorf("Wrapped list output = %q, want %q", got, want)
+	}
+}
+
+func TestMarkdownInline(t *testing.T) {
+	code := rich.NewStyle().Foreground(rich.Cyan)
+
+	tests := []struct {
+		input string
+		want  []rich.Segment
+	}{
+		{"plain", []rich.Segment{{Text: "plain"}}},
+		{"a **b** c", []rich.Segment{{Text: "a "}, {Text: "b", Style: rich.NewStyle().Bold()}, {Text: " c"}}},
+		{"*i* and _j_", []rich.Segment{
+			{Text: "i", Style: rich.NewStyle().Italic()},
+			{Text: " and "},
+			{Text: "j", Style: rich.NewStyle().Italic()},
+		}},
+		{"run `go test`", []rich.Segment{{Text: "run "}, {Text: "go test", Style: code}}},
+		{"snake_case_name", []rich.Segment{{Text: "snake_case_name"}}},
+		{"2 * 3", []rich.Segment{{Text: "2 * 3"}}},
+		{`\*literal\*`, []rich.Segment{{Text: "*literal*"}}},
+	}
+
+	for _, tt := range tests {
+		got := parseInline(tt.input, rich.NewStyle(), code)
+		if len(got) != len(tt.want) {
+			t.Errorf("parseInline(%q) = %+v, want %+v", tt.input, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i].Text != tt.want[i].Text || !got[i].Style.Equal(tt.want[i].Style) {
+				t.Errorf("parseInline(%q)[%d] = %+v, want %+v", tt.input, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestMarkdownCodeBlockAndRule(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	source := "Intro text\nwraps here.\n\n```go\nfmt.Println(\"hi\")\n```\n\n---"
+	lines := strings.Split(New(source).Render(console, 24).String(), "\n")
+
+	want := []string{
+		"Intro text wraps here.",
+		"",
+		"╭──────────────────────╮",
+		"│ fmt.Println(\"hi\")    │",
+		"╰──────────────────────╯",
+		"",
+		"────────────────────────",
+	}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("Output =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestMarkdownHighlightsCodeBlocks(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	segments := New("```go\nreturn nil\n```").Render(console, 20)
+	seg, ok := findSegment(segments, "return")
+	if !ok {
+		t.Fatalf("Expected highlighted keyword segment, got %q", segments.String())
+	}
+	if !seg.Style.Equal(syntax.DefaultTheme.Keyword) {
+		t.Errorf("Expected keyword style, got %+v", seg.Style)
+	}
+}
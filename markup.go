@@ -17,11 +17,12 @@ import (
 //
 // The markup language supports:
 //   - Style tags: [bold], [italic], [underline], etc.
-//   - Color tags: [red], [#FF0000], [rgb(255,0,0)]
+//   - Color tags: [red], [#FF0000], [rgb(255,0,0)], [color(196)]
 //   - Background colors: [red on blue]
 //   - Combined styles: [bold red on white]
 //   - Close tags: [/]
 //   - Escaped brackets: [[
+//   - Padding to a column: [pad=20] (no close tag)
 
 // markupTokenType represents the type of a markup token.
 // The lexer categorizes input into these token types during tokenization.
@@ -274,12 +275,15 @@ func (p *markupParser) popStyle() {
 // Token handling:
 //   - TEXT: Create a segment with current style, handle escaped brackets
 //   - OPEN TAG: Parse the style and push it onto the stack
-//   - CLOSE TAG: Pop a style from the stack
+//   - CLOSE TAG: Pop a style from the stack (extra close tags are ignored)
 //   - EOF: Return completed segments
 //
 // Invalid tags (parse errors) are treated as literal text rather than
 // causing the entire parse to fail. This provides graceful degradation.
 //
+// A tag left open styles the rest of the text. Use ParseMarkupStrict to
+// reject unbalanced markup instead.
+//
 // Example:
 //
 //	Input tokens: OPEN("[bold]"), TEXT("Hi"), CLOSE("[/]")
@@ -306,6 +310,17 @@ func (p *markupParser) parse() (Segments, error) {
 			}
 
 		case markupTokenOpenTag:
+			// Padding directive: space up to the column, no style to push
+			if column, ok := padDirective(token.value); ok {
+				if n := column - lineWidth(segments); n > 0 {
+					segments = append(segments, Segment{
+						Text:  strings.Repeat(" ", n),
+						Style: p.currentStyle(),
+					})
+				}
+				continue
+			}
+
 			// Parse the tag to extract style attributes
 			style, err := p.parseTag(token.value)
 			if err != nil {
@@ -333,16 +348,50 @@ func (p *markupParser) parse() (Segments, error) {
 	return segments, nil
 }
 
+// padDirective reports whether tag is a [pad=N] directive, and returns its
+// column N. The directive pads with spaces so the text after it starts at
+// column N (counting from 0 at the start of the line).
+func padDirective(tag string) (column int, ok bool) {
+	tag = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(tag, "["), "]"))
+	value, found := strings.CutPrefix(tag, "pad=")
+	if !found {
+		return 0, false
+	}
+	column, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || column < 0 {
+		return 0, false
+	}
+	return column, true
+}
+
+// lineWidth returns the display width of the last line of segments: the
+// text after the last newline, or all of it if there is none.
+func lineWidth(segments Segments) int {
+	width := 0
+	for i := len(segments) - 1; i >= 0; i-- {
+		text := segments[i].Text
+		if nl := strings.LastIndexByte(text, '\n'); nl >= 0 {
+			return width + DisplayWidth(text[nl+1:])
+		}
+		width += DisplayWidth(text)
+	}
+	return width
+}
+
 // parseTag parses a tag string like "[bold red]" into a style.
 // The tag content (without brackets) is split into space-separated parts,
 // and each part is interpreted as a style attribute or color.
 //
 // Supported tag components:
 //   - Attributes: bold, italic, underline, strikethrough, dim, reverse
-//   - Foreground colors: red, #FF0000, rgb(255,0,0)
-//   - Background colors: on blue, on #0000FF
+//   - Foreground colors: red, #FF0000, rgb(255,0,0), color(196)
+//   - Background colors: on blue, on #0000FF, on color(21)
+//   - Underline colors: underline=#FF0000, u=red (also enables underline)
 //   - Combinations: "bold red on blue"
 //
+// Padding directives ([pad=N]) are not style tags; parse handles them
+// before calling parseTag.
+//
 // The resulting style is based on the current style with new attributes added.
 // This allows tags to accumulate styles: [bold][red]text[/][/] applies both.
 //
@@ -406,6 +455,17 @@ func (p *markupParser) parseTag(tag string) (Style, error) {
 			}
 
 		default:
+			// "underline=COLOR" sets an underline color and enables underline
+			if name, value, ok := strings.Cut(part, "="); ok {
+				switch strings.ToLower(name) {
+				case "underline", "u":
+					if color, err := parseMarkupColor(value); err == nil {
+						style = style.UnderlineColor(color)
+					}
+				}
+				break
+			}
+
 			// Try to parse as a foreground color
 			color, err := parseMarkupColor(part)
 			if err == nil {
@@ -422,8 +482,9 @@ func (p *markupParser) parseTag(tag string) (Style, error) {
 
 // parseMarkupColor parses a color string from markup.
 // Supports multiple color formats:
-//   - Hex colors: #FF0000, #00ff00 (case-insensitive)
+//   - Hex colors: #FF0000, #00ff00, #f00 (case-insensitive; alpha is ignored)
 //   - RGB function: rgb(255,0,0), rgb(0, 255, 0)
+//   - 256-color palette index: color(196)
 //   - ANSI color names: red, blue, green, etc.
 //   - Bright colors: bright_red, bright_blue
 //   - Gray/grey: both spellings accepted
@@ -436,13 +497,14 @@ func (p *markupParser) parseTag(tag string) (Style, error) {
 //
 //	"#FF0000" → RGBColor{255, 0, 0}
 //	"rgb(255,0,0)" → RGBColor{255, 0, 0}
+//	"color(196)" → ANSI256Color(196)
 //	"red" → ANSIColor Red
 //	"orange" → RGBColor{255, 165, 0}
 func parseMarkupColor(s string) (Color, error) {
 	// Normalize to lowercase for case-insensitive matching
 	s = strings.ToLower(s)
 
-	// Check for hex color (#RRGGBB format)
+	// Check for hex color (#RRGGBB, #RGB, or with alpha)
 	if strings.HasPrefix(s, "#") {
 		return Hex(s)
 	}
@@ -468,6 +530,14 @@ func parseMarkupColor(s string) (Color, error) {
 		}
 	}
 
+	// Check for color(n) 256-color palette index format
+	if strings.HasPrefix(s, "color(") && strings.HasSuffix(s, ")") {
+		index := strings.TrimSuffix(strings.TrimPrefix(s, "color("), ")")
+		if n, err := strconv.Atoi(strings.TrimSpace(index)); err == nil && n >= 0 && n <= 255 {
+			return ANSI256Color(n), nil
+		}
+	}
+
 	// Check for ANSI color names (standard 16 colors)
 	switch s {
 	case "black":
@@ -543,6 +613,35 @@ func parseMarkup(markup string) (Segments, error) {
 	return parser.parse()
 }
 
+// ParseMarkup converts markup text into styled segments, using the same
+// syntax as Console.PrintMarkup. It lets renderables in other packages accept
+// markup for labels and descriptions.
+//
+// Example:
+//
+//	segments, err := rich.ParseMarkup("[bold]Downloading[/] file.txt")
+//	// segments[0] is "Downloading" in bold, segments[1] is " file.txt"
+func ParseMarkup(markup string) (Segments, error) {
+	return parseMarkup(markup)
+}
+
+// ParseMarkupStrict is like ParseMarkup, but returns an error if the tags
+// are unbalanced: an opening tag without a matching [/], or a [/] with no
+// tag to close (see ValidateMarkup). Use it for markup supplied by users,
+// where a mistake should be reported rather than rendered.
+//
+// Example:
+//
+//	if _, err := rich.ParseMarkupStrict(userLabel); err != nil {
+//		return fmt.Errorf("invalid label: %w", err)
+//	}
+func ParseMarkupStrict(markup string) (Segments, error) {
+	if err := ValidateMarkup(markup); err != nil {
+		return nil, err
+	}
+	return parseMarkup(markup)
+}
+
 // printMarkupInternal is the internal implementation of PrintMarkup.
 // Parses the markup into segments and writes them to the console.
 // If parsing fails, falls back to printing the raw markup as plain text.
@@ -550,7 +649,7 @@ func (c *Console) printMarkupInternal(m string) (n int, err error) {
 	segments, err := parseMarkup(m)
 	if err != nil {
 		// On error, print raw markup without styling
-		return c.writer.Write([]byte(m))
+		return c.write(m)
 	}
 	return c.PrintSegments(segments)
 }
@@ -561,7 +660,8 @@ func (c *Console) printMarkupInternal(m string) (n int, err error) {
 //   - Extracting plain text for logging or storage
 //   - Generating non-styled versions of marked-up content
 //
-// Escaped brackets [[ are correctly converted to single brackets [.
+// Escaped brackets [[ are correctly converted to single brackets [, and
+// [pad=N] directives are replaced by the spaces they insert.
 //
 // Example:
 //
@@ -582,6 +682,15 @@ func StripMarkup(markup string) string {
 			// Convert escaped brackets [[ → [
 			result.WriteString(strings.ReplaceAll(token.value, "[[", "["))
 		}
+
+		// Padding directives still insert their spaces
+		if column, ok := padDirective(token.value); ok && token.typ == markupTokenOpenTag {
+			text := result.String()
+			line := text[strings.LastIndexByte(text, '\n')+1:]
+			if n := column - DisplayWidth(line); n > 0 {
+				result.WriteString(strings.Repeat(" ", n))
+			}
+		}
 	}
 
 	return result.String()
@@ -631,8 +740,10 @@ func ValidateMarkup(markup string) error {
 
 		switch token.typ {
 		case markupTokenOpenTag:
-			// Opening tag increases depth
-			depth++
+			// Opening tag increases depth (padding directives need no close)
+			if _, ok := padDirective(token.value); !ok {
+				depth++
+			}
 
 		case markupTokenCloseTag:
 			// Closing tag decreases depth
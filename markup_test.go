@@ -3,6 +3,8 @@ package rich
 import (
 	"strings"
 	"testing"
+
+	"github.com/eberle1080/go-rich/internal/ansi"
 )
 
 func TestStripMarkup(t *testing.T) {
@@ -179,6 +181,32 @@ func TestConsolePrintMarkupln(t *testing.T) {
 	}
 }
 
+func TestConsolePrintMarkupStrict(t *testing.T) {
+	var buf strings.Builder
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+
+	// Unbalanced markup is rejected and nothing is written
+	for _, markup := range []string{"[bold]Hello", "Hello[/]", "[bold]Hello[/][/]"} {
+		buf.Reset()
+		n, err := console.PrintMarkupStrict(markup)
+		if err == nil {
+			t.Errorf("PrintMarkupStrict(%q): expected an error", markup)
+		}
+		if n != 0 || buf.Len() != 0 {
+			t.Errorf("PrintMarkupStrict(%q): expected no output, got %q", markup, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if _, err := console.PrintMarkupStrict("[bold]Hello[/] World"); err != nil {
+		t.Errorf("PrintMarkupStrict error = %v", err)
+	}
+	if plain := ansi.StripANSI(buf.String()); plain != "Hello World" {
+		t.Errorf("PrintMarkupStrict output = %q, want %q", plain, "Hello World")
+	}
+}
+
 func TestConsolePrintMarkupWithColors(t *testing.T) {
 	var buf strings.Builder
 	console := NewConsole(&buf)
@@ -205,6 +233,8 @@ func TestMarkupColorParsing(t *testing.T) {
 	}{
 		{"[red]text[/]", true},
 		{"[#FF0000]text[/]", true},
+		{"[#F00]text[/]", true},
+		{"[#FF000080]text[/]", true},
 		{"[rgb(255,0,0)]text[/]", true},
 		{"[bright_red]text[/]", true},
 		{"[orange]text[/]", true},
@@ -228,3 +258,90 @@ func TestMarkupColorParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkupColor256(t *testing.T) {
+	segments, err := parseMarkup("[color(196)]x[/]")
+	if err != nil {
+		t.Fatalf("parseMarkup error = %v", err)
+	}
+	if fg, ok := segments[0].Style.fg.(ANSI256Color); !ok || fg != 196 {
+		t.Errorf("Expected foreground color(196), got %v", segments[0].Style.fg)
+	}
+
+	segments, err = parseMarkup("[bold on color(21)]x[/]")
+	if err != nil {
+		t.Fatalf("parseMarkup error = %v", err)
+	}
+	if bg, ok := segments[0].Style.bg.(ANSI256Color); !ok || bg != 21 {
+		t.Errorf("Expected background color(21), got %v", segments[0].Style.bg)
+	}
+
+	// Out-of-range indices are not colors
+	for _, s := range []string{"color(256)", "color(-1)", "color(x)"} {
+		if _, err := parseMarkupColor(s); err == nil {
+			t.Errorf("parseMarkupColor(%q): expected an error", s)
+		}
+	}
+}
+
+func TestMarkupPad(t *testing.T) {
+	tests := []struct {
+		markup string
+		want   string
+	}{
+		{"Name:[pad=10]Alice", "Name:     Alice"},
+		{"[bold]Status:[/][pad=10][green]ok[/]", "Status:   ok"},
+		{"日本:[pad=10]x", "日本:     x"},
+		{"Description:[pad=10]long", "Description:long"},
+		{"a[pad=4]b[pad=8]c", "a   b   c"},
+		{"first line\nab[pad=5]c", "first line\nab   c"},
+		{"[pad=3]x", "   x"},
+	}
+
+	for _, tt := range tests {
+		segments, err := parseMarkup(tt.markup)
+		if err != nil {
+			t.Errorf("parseMarkup(%q) error = %v", tt.markup, err)
+			continue
+		}
+		if got := segments.String(); got != tt.want {
+			t.Errorf("parseMarkup(%q) = %q, want %q", tt.markup, got, tt.want)
+		}
+		if got := StripMarkup(tt.markup); got != tt.want {
+			t.Errorf("StripMarkup(%q) = %q, want %q", tt.markup, got, tt.want)
+		}
+	}
+
+	// The text after the directive starts at the column
+	segments, _ := parseMarkup("[bold]key:[/][pad=10]value")
+	text := segments.String()
+	if col := DisplayWidth(text[:strings.Index(text, "value")]); col != 10 {
+		t.Errorf("Expected value at column 10, got %d", col)
+	}
+
+	// Padding directives need no close tag
+	if err := ValidateMarkup("key:[pad=10][bold]value[/]"); err != nil {
+		t.Errorf("ValidateMarkup error = %v", err)
+	}
+}
+
+func TestMarkupUnderlineColor(t *testing.T) {
+	segments, err := parseMarkup("[underline=#ff0000]text[/]")
+	if err != nil {
+		t.Fatalf("parseMarkup error: %v", err)
+	}
+
+	style := segments[0].Style
+	if !style.IsUnderline() {
+		t.Error("Expected underline to be enabled")
+	}
+	if style.UlColor() != RGB(255, 0, 0) {
+		t.Errorf("Expected underline color #ff0000, got %v", style.UlColor())
+	}
+
+	// Short form with a named color, combined with a foreground color
+	segments, _ = parseMarkup("[blue u=red]text[/]")
+	if segments[0].Style.UlColor() != Red || segments[0].Style.FgColor() != Blue {
+		t.Errorf("Expected blue text with red underline")
+	}
+}
@@ -155,3 +155,29 @@ func (m Measurement) Get(available int) int {
 	// Use all available space (between Minimum and Maximum)
 	return available
 }
+
+// MeasureRenderable measures any renderable, not just Measurable ones.
+// If r implements Measurable, its Measure method is used. Otherwise r is
+// rendered at maxWidth and the widest resulting line (in display columns)
+// is reported as both Minimum and Maximum.
+//
+// Layout renderables (Group, Columns, Align) use this to size children of
+// any kind.
+//
+// Example:
+//
+//	m := rich.MeasureRenderable(console, child, console.Width())
+//	fmt.Println(m.Maximum) // Natural width of child
+func MeasureRenderable(console *Console, r Renderable, maxWidth int) Measurement {
+	if measurable, ok := r.(Measurable); ok {
+		return measurable.Measure(console, maxWidth)
+	}
+
+	width := 0
+	for _, line := range r.Render(console, maxWidth).SplitLines() {
+		if w := line.Width(); w > width {
+			width = w
+		}
+	}
+	return Measurement{Minimum: width, Maximum: width}
+}
@@ -86,3 +86,22 @@ func TestMeasurementGet(t *testing.T) {
 		t.Errorf("Get(5) = %d, want 10 (minimum)", got)
 	}
 }
+
+func TestMeasureRenderable(t *testing.T) {
+	console := NewConsole(nil)
+
+	// Measurable renderables report their own measurement
+	m := MeasureRenderable(console, NewRenderableString("hello", NewStyle()), 80)
+	if m.Minimum != 5 || m.Maximum != 5 {
+		t.Errorf("Expected {5 5}, got %+v", m)
+	}
+
+	// Others are rendered and their widest line measured
+	m = MeasureRenderable(console, Lines{
+		NewRenderableString("ab", NewStyle()),
+		NewRenderableString("日本語", NewStyle()),
+	}, 80)
+	if m.Minimum != 6 || m.Maximum != 6 {
+		t.Errorf("Expected {6 6}, got %+v", m)
+	}
+}
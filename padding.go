@@ -0,0 +1,96 @@
+package rich
+
+import "strings"
+
+// Padded is a renderable that surrounds another renderable with blank space.
+// Create one with Padding.
+type Padded struct {
+	child  Renderable // The renderable being padded
+	top    int        // Blank lines above the child
+	right  int        // Spaces to the right of every line
+	bottom int        // Blank lines below the child
+	left   int        // Spaces to the left of every line
+}
+
+// Padding wraps child with blank space on each side, in CSS order: top and
+// bottom are blank lines, right and left are spaces. The child is rendered
+// with the horizontal padding subtracted from the available width, and its
+// lines are padded to a common block width so the right padding lines up
+// even when the child's lines differ in length. Negative values are treated
+// as 0.
+//
+// Example:
+//
+//	// Give a table some breathing room inside a group
+//	console.Renderln(rich.NewGroup(
+//		title,
+//		rich.Padding(tbl, 1, 2, 1, 2),
+//	))
+func Padding(child Renderable, top, right, bottom, left int) *Padded {
+	return &Padded{
+		child:  child,
+		top:    max(top, 0),
+		right:  max(right, 0),
+		bottom: max(bottom, 0),
+		left:   max(left, 0),
+	}
+}
+
+// Render implements Renderable.
+// Every line, including the blank padding lines, has the same width: the
+// child's widest line plus the left and right padding.
+func (p *Padded) Render(console *Console, width int) Segments {
+	var lines []Segments
+	if p.child != nil {
+		lines = blockLines(p.child.Render(console, max(width-p.left-p.right, 1)))
+	}
+
+	blockWidth := 0
+	for _, line := range lines {
+		blockWidth = max(blockWidth, line.Width())
+	}
+	totalWidth := p.left + blockWidth + p.right
+
+	blank := Segments{{Text: strings.Repeat(" ", totalWidth)}}
+	leftPad := Segment{Text: strings.Repeat(" ", p.left)}
+	rightPad := Segment{Text: strings.Repeat(" ", p.right)}
+
+	var padded []Segments
+	for i := 0; i < p.top; i++ {
+		padded = append(padded, blank)
+	}
+	for _, line := range lines {
+		var row Segments
+		if p.left > 0 {
+			row = append(row, leftPad)
+		}
+		row = append(row, fitLine(line, blockWidth)...)
+		if p.right > 0 {
+			row = append(row, rightPad)
+		}
+		padded = append(padded, row)
+	}
+	for i := 0; i < p.bottom; i++ {
+		padded = append(padded, blank)
+	}
+
+	var result Segments
+	for i, line := range padded {
+		if i > 0 {
+			result = append(result, Segment{Text: "\n"})
+		}
+		result = append(result, line...)
+	}
+	return result
+}
+
+// Measure implements Measurable.
+// The padded block needs the child's width plus the left and right padding.
+func (p *Padded) Measure(console *Console, maxWidth int) Measurement {
+	horizontal := p.left + p.right
+	var m Measurement
+	if p.child != nil {
+		m = MeasureRenderable(console, p.child, max(maxWidth-horizontal, 1))
+	}
+	return m.Add(Measurement{Minimum: horizontal, Maximum: horizontal})
+}
@@ -0,0 +1,40 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPadding(t *testing.T) {
+	console := NewConsole(nil)
+
+	padded := Padding(NewRenderableString("hi", NewStyle()), 1, 3, 2, 2)
+	lines := strings.Split(padded.Render(console, 20).String(), "\n")
+
+	want := []string{
+		"       ",
+		"  hi   ",
+		"       ",
+		"       ",
+	}
+	if strings.Join(lines, "|") != strings.Join(want, "|") {
+		t.Errorf("Padding output = %q, want %q", lines, want)
+	}
+
+	if m := padded.Measure(console, 20); m.Minimum != 7 || m.Maximum != 7 {
+		t.Errorf("Expected measurement {7 7}, got %+v", m)
+	}
+}
+
+func TestPaddingBlockWidth(t *testing.T) {
+	console := NewConsole(nil)
+
+	// Lines of different lengths are padded to a common block width
+	padded := Padding(NewRenderableString("a\nabc", NewStyle()), 0, 1, 0, 1)
+	got := strings.Split(padded.Render(console, 20).String(), "\n")
+
+	want := []string{" a   ", " abc "}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Padding output = %q, want %q", got, want)
+	}
+}
@@ -85,6 +85,19 @@ const (
 	AlignRight
 )
 
+// mirror returns the alignment as seen from the opposite side.
+// Used for right-to-left consoles, where the origin is the right edge.
+func (a Align) mirror() Align {
+	switch a {
+	case AlignLeft:
+		return AlignRight
+	case AlignRight:
+		return AlignLeft
+	default:
+		return a
+	}
+}
+
 // Panel represents a bordered container for content.
 // Panels wrap content in a box with borders, optional title and subtitle,
 // and customizable styling. They implement rich.Renderable and can be
@@ -106,14 +119,20 @@ const (
 type Panel struct {
 	content rich.Renderable // The content to display inside the panel
 
-	title    string // Optional title displayed at top (centered)
+	title    string // Optional title displayed at top
 	subtitle string // Optional subtitle displayed at bottom (centered)
 
+	titleAlign    Align // Title alignment (default: centered)
+	titleInBorder bool  // If true, embed the title in the top border instead of its own row
+
 	box table.Box // Border characters (from table package)
 
-	width   int   // Fixed width (0 = auto-size based on content/expand)
-	padding int   // Internal padding (spaces between border and content)
-	align   Align // Content alignment (left, center, right)
+	width     int   // Fixed width (0 = auto-size based on content/expand)
+	padTop    int   // Blank lines between the top border (or title) and content
+	padRight  int   // Spaces between content and the right border
+	padBottom int   // Blank lines between content and the bottom border (or subtitle)
+	padLeft   int   // Spaces between the left border and content
+	align     Align // Content alignment (left, center, right)
 
 	borderStyle  rich.Style // Style for border characters
 	titleStyle   rich.Style // Style for title and subtitle text
@@ -163,7 +182,9 @@ func New(content interface{}) *Panel {
 	return &Panel{
 		content:      renderable,
 		box:          table.BoxRounded,
-		padding:      1,
+		titleAlign:   AlignCenter,
+		padLeft:      1,
+		padRight:     1,
 		align:        AlignLeft,
 		borderStyle:  rich.NewStyle().Dim(),
 		titleStyle:   rich.NewStyle().Bold(),
@@ -173,7 +194,8 @@ func New(content interface{}) *Panel {
 }
 
 // Title sets the panel title displayed at the top.
-// The title is centered and shown in its own row above the content.
+// By default the title is centered and shown in its own row above the
+// content. See TitleAlign and TitleInBorder to change its placement.
 // If empty (default), no title row is displayed.
 //
 // Example:
@@ -184,6 +206,38 @@ func (p *Panel) Title(title string) *Panel {
 	return p
 }
 
+// TitleAlign sets the horizontal alignment of the title.
+// Applies both to a title in its own row and to a title embedded in the
+// top border (see TitleInBorder).
+// Default is AlignCenter.
+//
+// Example:
+//
+//	panel.New("Message").Title("Alert").TitleAlign(panel.AlignLeft)
+func (p *Panel) TitleAlign(align Align) *Panel {
+	p.titleAlign = align
+	return p
+}
+
+// TitleInBorder controls where the title is drawn.
+// When true, the title is embedded in the top border line, surrounded by
+// a space on each side, instead of occupying its own row:
+//
+//	╭─ Title ──────────╮
+//	│ Content          │
+//	╰──────────────────╯
+//
+// If the panel is too narrow to fit any of the title in the border, a
+// plain top border is drawn. Default is false.
+//
+// Example:
+//
+//	panel.New("Message").Title("Alert").TitleInBorder(true)
+func (p *Panel) TitleInBorder(inBorder bool) *Panel {
+	p.titleInBorder = inBorder
+	return p
+}
+
 // Subtitle sets the panel subtitle displayed at the bottom.
 // The subtitle is centered and shown in its own row below the content.
 // If empty (default), no subtitle row is displayed.
@@ -223,18 +277,42 @@ func (p *Panel) Width(width int) *Panel {
 	return p
 }
 
-// Padding sets the internal padding in characters.
-// Padding is added to all four sides (top, right, bottom, left) of the content.
+// Padding sets the horizontal padding in characters.
+// Padding is added to the left and right of the content; it is a shortcut
+// for PaddingSides(0, padding, 0, padding). Use PaddingSides to also add
+// blank lines above and below the content.
 // Default is 1.
 //
 // Example:
 //
-//	panel.New("Message").Padding(2) // 2 spaces on all sides
+//	panel.New("Message").Padding(2) // 2 spaces on each side
 func (p *Panel) Padding(padding int) *Panel {
-	p.padding = padding
+	return p.PaddingSides(0, padding, 0, padding)
+}
+
+// PaddingSides sets the padding for each side individually, in CSS order.
+// Left and right padding are spaces between the borders and the content;
+// top and bottom padding are blank lines between the content and the top
+// and bottom borders (or the title and subtitle rows, if present).
+// Negative values are treated as 0.
+//
+// Example:
+//
+//	// More horizontal than vertical breathing room
+//	panel.New("Message").PaddingSides(1, 4, 1, 4)
+func (p *Panel) PaddingSides(top, right, bottom, left int) *Panel {
+	p.padTop = max(top, 0)
+	p.padRight = max(right, 0)
+	p.padBottom = max(bottom, 0)
+	p.padLeft = max(left, 0)
 	return p
 }
 
+// horizontalPadding returns the total left and right padding.
+func (p *Panel) horizontalPadding() int {
+	return p.padLeft + p.padRight
+}
+
 // Align sets the content alignment.
 // Determines how content is positioned horizontally when it's narrower
 // than the panel's inner width.
@@ -309,7 +387,7 @@ func (p *Panel) Expand(expand bool) *Panel {
 //  2. Calculate content width (panel width minus borders and padding)
 //  3. Render top border
 //  4. Render title row (if title is set)
-//  5. Render content lines with borders and padding
+//  5. Render content lines with borders and padding (string content is word-wrapped)
 //  6. Render subtitle row (if subtitle is set)
 //  7. Render bottom border
 //
@@ -325,8 +403,8 @@ func (p *Panel) Render(console *rich.Console, maxWidth int) rich.Segments {
 			// Use full available width
 			width = maxWidth
 		} else {
-			// Auto-size to fit content
-			width = p.measureContent(console, maxWidth)
+			// Auto-size to fit content, but never beyond the available width
+			width = min(p.measureContent(console, maxWidth), maxWidth)
 		}
 	}
 
@@ -336,8 +414,8 @@ func (p *Panel) Render(console *rich.Console, maxWidth int) rich.Segments {
 	}
 
 	// Calculate content width (total width minus borders and padding)
-	// Formula: contentWidth = width - 2 (borders) - 2*padding
-	contentWidth := width - 2 - (p.padding * 2)
+	// Formula: contentWidth = width - 2 (borders) - left and right padding
+	contentWidth := width - 2 - p.horizontalPadding()
 	if contentWidth < 1 {
 		contentWidth = 1 // Ensure at least 1 char of content width
 	}
@@ -348,8 +426,8 @@ func (p *Panel) Render(console *rich.Console, maxWidth int) rich.Segments {
 	segments = append(segments, p.renderTopBorder(width)...)
 	segments = append(segments, rich.Segment{Text: "\n"})
 
-	// Render title if present
-	if p.title != "" {
+	// Render title if present (unless it's embedded in the top border)
+	if p.title != "" && !p.titleInBorder {
 		segments = append(segments, p.renderTitle(width)...)
 		segments = append(segments, rich.Segment{Text: "\n"})
 	}
@@ -358,12 +436,34 @@ func (p *Panel) Render(console *rich.Console, maxWidth int) rich.Segments {
 	// First, get the content as segments
 	contentSegments := p.content.Render(console, contentWidth)
 
-	// Split content into lines (handle newlines)
-	contentLines := p.splitIntoLines(contentSegments)
+	// Split content into lines. Plain string content is word-wrapped to the
+	// content width; other renderables handle their own layout, and any
+	// lines that are still too long get truncated.
+	var contentLines []rich.Segments
+	if _, ok := p.content.(*rich.RenderableString); ok {
+		contentLines = p.wrapLines(contentSegments, contentWidth)
+	} else {
+		contentLines = p.splitIntoLines(contentSegments)
+	}
+
+	// Right-to-left consoles align content from the right edge
+	align := p.align
+	if console.IsRTL() {
+		align = align.mirror()
+	}
 
-	// Render each line with borders and padding
+	// Render each line with borders and padding, surrounded by blank lines
+	// for the top and bottom padding
+	for i := 0; i < p.padTop; i++ {
+		segments = append(segments, p.renderContentLine(nil, width, contentWidth, align)...)
+		segments = append(segments, rich.Segment{Text: "\n"})
+	}
 	for _, line := range contentLines {
-		segments = append(segments, p.renderContentLine(line, width, contentWidth)...)
+		segments = append(segments, p.renderContentLine(line, width, contentWidth, align)...)
+		segments = append(segments, rich.Segment{Text: "\n"})
+	}
+	for i := 0; i < p.padBottom; i++ {
+		segments = append(segments, p.renderContentLine(nil, width, contentWidth, align)...)
 		segments = append(segments, rich.Segment{Text: "\n"})
 	}
 
@@ -379,45 +479,53 @@ func (p *Panel) Render(console *rich.Console, maxWidth int) rich.Segments {
 	return segments
 }
 
-// measureContent measures the optimal width for the panel based on content.
-// Used when expand is false and no fixed width is set.
+// Measure implements rich.Measurable.
+// Reports the panel's total width range, including borders and padding:
+//   - A fixed Width (that fits in maxWidth) is both the minimum and maximum
+//   - Otherwise the minimum is the content's minimum plus borders and padding
+//   - The maximum is maxWidth when expanding, or the content's natural width
+//     plus borders and padding when not
 //
-// The measurement strategy:
-//  1. If content implements Measurable, use its Measure method (efficient)
-//  2. Otherwise, render content and measure the longest line (fallback)
+// This lets panels be nested in other panels, tables, and layouts without
+// rendering them first.
 //
-// Returns the total panel width including borders and padding:
-//
-//	contentWidth + 2 (borders) + 2*padding
+// Example:
 //
-// The maxWidth parameter constrains the measurement to available space.
-func (p *Panel) measureContent(console *rich.Console, maxWidth int) int {
-	// Try to measure efficiently if content supports it
-	if measurable, ok := p.content.(rich.Measurable); ok {
-		// Measure content with available inner width
-		measurement := measurable.Measure(console, maxWidth-2-(p.padding*2))
-		// Add borders and padding to get total panel width
-		return measurement.Maximum + 2 + (p.padding * 2)
+//	m := panel.New(tbl).Expand(false).Measure(console, console.Width())
+//	fmt.Println(m.Maximum) // Width the panel will render at
+func (p *Panel) Measure(console *rich.Console, maxWidth int) rich.Measurement {
+	if p.width > 0 && p.width <= maxWidth {
+		return rich.Measurement{Minimum: max(p.width, 3), Maximum: max(p.width, 3)}
 	}
 
-	// Fallback: render and measure manually
-	// Render content at max available width
-	segments := p.content.Render(console, maxWidth-2-(p.padding*2))
-
-	// Split into lines
-	lines := p.splitIntoLines(segments)
+	structure := 2 + p.horizontalPadding()
+	content := rich.MeasureRenderable(console, p.content, maxWidth-structure)
 
-	// Find the longest line
-	maxLen := 0
-	for _, line := range lines {
-		lineLen := line.Length()
-		if lineLen > maxLen {
-			maxLen = lineLen
-		}
+	m := content.Add(rich.Measurement{Minimum: structure, Maximum: structure})
+	if p.expand {
+		m.Maximum = maxWidth
 	}
+	return m.Clamp(3, max(maxWidth, 3))
+}
 
-	// Add borders and padding to content width
-	return maxLen + 2 + (p.padding * 2)
+// measureContent measures the optimal width for the panel based on content.
+// Used when expand is false and no fixed width is set.
+//
+// The content is measured with rich.MeasureRenderable, which uses the
+// content's Measure method when it implements Measurable and otherwise
+// renders it and measures the longest line.
+//
+// Widths are display widths (see rich.DisplayWidth), not rune counts, so
+// wide characters such as CJK and emoji count as two cells and the panel
+// exactly contains its widest line. Returns the total panel width including
+// borders and padding:
+//
+//	contentWidth + 2 (borders) + left and right padding
+//
+// The maxWidth parameter constrains the measurement to available space.
+func (p *Panel) measureContent(console *rich.Console, maxWidth int) int {
+	structure := 2 + p.horizontalPadding()
+	return rich.MeasureRenderable(console, p.content, maxWidth-structure).Maximum + structure
 }
 
 // splitIntoLines splits segments into lines based on newline characters.
@@ -475,12 +583,49 @@ func (p *Panel) splitIntoLines(segments rich.Segments) []rich.Segments {
 	return lines
 }
 
+// wrapLines word-wraps string content to the content width.
+// Like splitIntoLines, a trailing newline doesn't produce an extra blank line.
+func (p *Panel) wrapLines(segments rich.Segments, width int) []rich.Segments {
+	lines := segments.Wrap(width)
+	if n := len(lines); n > 0 && len(lines[n-1]) == 0 {
+		lines = lines[:n-1]
+	}
+	return lines
+}
+
 // renderTopBorder renders the top border.
+// When the title is embedded in the border, it is placed according to the
+// title alignment with at least one border character on each side:
+//
+//	╭─ Title ───╮  (left)
+//	╭── Title ──╮  (center)
+//	╭─── Title ─╮  (right)
 func (p *Panel) renderTopBorder(width int) rich.Segments {
 	innerWidth := width - 2
-	line := p.box.TopLeft + strings.Repeat(p.box.Top, innerWidth) + p.box.TopRight
 
-	return rich.Segments{{Text: line, Style: p.borderStyle}}
+	// Room left for the title after one border character and one space on each side
+	available := innerWidth - 4
+	if !p.titleInBorder || p.title == "" || available < 1 {
+		line := p.box.TopLeft + strings.Repeat(p.box.Top, innerWidth) + p.box.TopRight
+		return rich.Segments{{Text: line, Style: p.borderStyle}}
+	}
+
+	title := rich.Truncate(p.title, available, "")
+	left, right := alignPadding(innerWidth-2-rich.DisplayWidth(title), p.titleAlign)
+
+	// Keep at least one border character on each side of the title
+	switch {
+	case left == 0:
+		left, right = 1, right-1
+	case right == 0:
+		left, right = left-1, 1
+	}
+
+	return rich.Segments{
+		{Text: p.box.TopLeft + strings.Repeat(p.box.Top, left) + " ", Style: p.borderStyle},
+		{Text: title, Style: p.titleStyle},
+		{Text: " " + strings.Repeat(p.box.Top, right) + p.box.TopRight, Style: p.borderStyle},
+	}
 }
 
 // renderBottomBorder renders the bottom border.
@@ -493,53 +638,21 @@ func (p *Panel) renderBottomBorder(width int) rich.Segments {
 
 // renderTitle renders the title line.
 func (p *Panel) renderTitle(width int) rich.Segments {
-	innerWidth := width - 2
-	titleLen := len(p.title)
-
-	var segments rich.Segments
-
-	segments = append(segments, rich.Segment{
-		Text:  p.box.Left,
-		Style: p.borderStyle,
-	})
-
-	if titleLen >= innerWidth {
-		// Title too long, truncate
-		segments = append(segments, rich.Segment{
-			Text:  p.title[:innerWidth],
-			Style: p.titleStyle,
-		})
-	} else {
-		// Center the title
-		leftPad := (innerWidth - titleLen) / 2
-		rightPad := innerWidth - titleLen - leftPad
-
-		if leftPad > 0 {
-			segments = append(segments, rich.Segment{Text: strings.Repeat(" ", leftPad)})
-		}
-
-		segments = append(segments, rich.Segment{
-			Text:  p.title,
-			Style: p.titleStyle,
-		})
-
-		if rightPad > 0 {
-			segments = append(segments, rich.Segment{Text: strings.Repeat(" ", rightPad)})
-		}
-	}
-
-	segments = append(segments, rich.Segment{
-		Text:  p.box.Right,
-		Style: p.borderStyle,
-	})
-
-	return segments
+	return p.renderTextRow(p.title, width, p.titleAlign)
 }
 
 // renderSubtitle renders the subtitle line.
 func (p *Panel) renderSubtitle(width int) rich.Segments {
+	return p.renderTextRow(p.subtitle, width, AlignCenter)
+}
+
+// renderTextRow renders a bordered row with text aligned in the title style.
+// Text wider than the inner width is truncated; all measurements are in
+// display columns so wide characters keep the right border aligned.
+func (p *Panel) renderTextRow(text string, width int, align Align) rich.Segments {
 	innerWidth := width - 2
-	subtitleLen := len(p.subtitle)
+	text = rich.Truncate(text, innerWidth, "")
+	leftPad, rightPad := alignPadding(innerWidth-rich.DisplayWidth(text), align)
 
 	var segments rich.Segments
 
@@ -548,29 +661,17 @@ func (p *Panel) renderSubtitle(width int) rich.Segments {
 		Style: p.borderStyle,
 	})
 
-	if subtitleLen >= innerWidth {
-		// Subtitle too long, truncate
-		segments = append(segments, rich.Segment{
-			Text:  p.subtitle[:innerWidth],
-			Style: p.titleStyle,
-		})
-	} else {
-		// Center the subtitle
-		leftPad := (innerWidth - subtitleLen) / 2
-		rightPad := innerWidth - subtitleLen - leftPad
-
-		if leftPad > 0 {
-			segments = append(segments, rich.Segment{Text: strings.Repeat(" ", leftPad)})
-		}
+	if leftPad > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", leftPad)})
+	}
 
-		segments = append(segments, rich.Segment{
-			Text:  p.subtitle,
-			Style: p.titleStyle,
-		})
+	segments = append(segments, rich.Segment{
+		Text:  text,
+		Style: p.titleStyle,
+	})
 
-		if rightPad > 0 {
-			segments = append(segments, rich.Segment{Text: strings.Repeat(" ", rightPad)})
-		}
+	if rightPad > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", rightPad)})
 	}
 
 	segments = append(segments, rich.Segment{
@@ -581,8 +682,8 @@ func (p *Panel) renderSubtitle(width int) rich.Segments {
 	return segments
 }
 
-// renderContentLine renders a single line of content.
-func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth int) rich.Segments {
+// renderContentLine renders a single line of content with the given alignment.
+func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth int, align Align) rich.Segments {
 	var segments rich.Segments
 
 	// Left border
@@ -592,21 +693,24 @@ func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth in
 	})
 
 	// Left padding
-	if p.padding > 0 {
-		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", p.padding)})
+	if p.padLeft > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", p.padLeft)})
 	}
 
 	// Content (aligned)
-	lineLen := line.Length()
+	lineLen := line.Width()
 	if lineLen > contentWidth {
-		// Truncate
+		// Truncate, padding out a column if a wide character was dropped
 		line = p.truncateLine(line, contentWidth)
 		segments = append(segments, line...)
+		if gap := contentWidth - line.Width(); gap > 0 {
+			segments = append(segments, rich.Segment{Text: strings.Repeat(" ", gap)})
+		}
 	} else {
 		// Align
 		padding := contentWidth - lineLen
 
-		switch p.align {
+		switch align {
 		case AlignLeft:
 			segments = append(segments, line...)
 			if padding > 0 {
@@ -633,8 +737,8 @@ func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth in
 	}
 
 	// Right padding
-	if p.padding > 0 {
-		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", p.padding)})
+	if p.padRight > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", p.padRight)})
 	}
 
 	// Right border
@@ -646,6 +750,22 @@ func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth in
 	return segments
 }
 
+// alignPadding splits extra space into left and right padding for the
+// given alignment. Centering puts any odd column on the right.
+func alignPadding(extra int, align Align) (left, right int) {
+	if extra <= 0 {
+		return 0, 0
+	}
+	switch align {
+	case AlignRight:
+		return extra, 0
+	case AlignCenter:
+		return extra / 2, extra - extra/2
+	default:
+		return 0, extra
+	}
+}
+
 // truncateLine truncates a line of segments to fit within a given width.
 // This is used when a line is longer than the available content width.
 //
@@ -655,10 +775,8 @@ func (p *Panel) renderContentLine(line rich.Segments, width int, contentWidth in
 //  3. Truncate the first segment that doesn't fit
 //  4. Discard all following segments
 //
-// The width parameter is in characters (not bytes).
-//
-// Note: This uses byte-based truncation (seg.Text[:remaining]), which may
-// split multi-byte Unicode characters. This is a known limitation.
+// The width parameter is in display columns. A wide character that would
+// straddle the limit is dropped, so the result may be one column narrower.
 //
 // Example:
 //
@@ -670,7 +788,7 @@ func (p *Panel) truncateLine(line rich.Segments, width int) rich.Segments {
 	remaining := width
 
 	for _, seg := range line {
-		segLen := len(seg.Text)
+		segLen := rich.DisplayWidth(seg.Text)
 
 		if segLen <= remaining {
 			// Segment fits completely
@@ -679,7 +797,7 @@ func (p *Panel) truncateLine(line rich.Segments, width int) rich.Segments {
 		} else if remaining > 0 {
 			// Segment needs truncation
 			result = append(result, rich.Segment{
-				Text:  seg.Text[:remaining],
+				Text:  rich.Truncate(seg.Text, remaining, ""),
 				Style: seg.Style,
 			})
 			// Stop processing after truncation
@@ -114,10 +114,10 @@ func TestPanelPadding(t *testing.T) {
 	}
 
 	// Just verify padding values are set correctly
-	if p1.padding != 0 {
+	if p1.padLeft != 0 || p1.padRight != 0 {
 		t.Error("Padding should be 0")
 	}
-	if p2.padding != 3 {
+	if p2.padLeft != 3 || p2.padRight != 3 {
 		t.Error("Padding should be 3")
 	}
 }
@@ -189,7 +189,7 @@ func TestPanelChaining(t *testing.T) {
 		t.Error("Width not set")
 	}
 
-	if p.padding != 2 {
+	if p.padLeft != 2 || p.padRight != 2 {
 		t.Error("Padding not set")
 	}
 
@@ -301,3 +301,343 @@ func TestTruncateLine(t *testing.T) {
 		t.Errorf("Expected 'This is a ', got %q", truncated.String())
 	}
 }
+
+func TestPanelRTL(t *testing.T) {
+	console := rich.NewConsole(nil)
+	console.SetRTL(true)
+
+	p := New("abc").Width(9)
+	lines := strings.Split(p.Render(console, 80).String(), "\n")
+
+	if lines[1] != "│   abc │" {
+		t.Errorf("RTL panel should pad content on the left, got %q", lines[1])
+	}
+}
+
+func TestPanelWideCharacters(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tests := []struct {
+		name  string
+		panel *Panel
+	}{
+		{"emoji title", New("Disk almost full").Title("⚠️ Warning").Width(30)},
+		{"cjk title", New("content").Title("日本語のタイトル").Width(20)},
+		{"cjk content", New("こんにちは世界").Subtitle("✅ done").Width(16)},
+		{"truncated wide title", New("x").Title("日本語のタイトル").Width(8)},
+		{"auto width", New("世界 🚀").Title("⚠️ Error").Expand(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := strings.Split(tt.panel.Render(console, 80).String(), "\n")
+			want := rich.DisplayWidth(lines[0])
+			for i, line := range lines {
+				if got := rich.DisplayWidth(line); got != want {
+					t.Errorf("line %d %q has width %d, want %d", i, line, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncateLineWide(t *testing.T) {
+	p := New("Test")
+
+	truncated := p.truncateLine(rich.Segments{{Text: "日本語"}}, 5)
+
+	if truncated.String() != "日本" {
+		t.Errorf("Expected '日本', got %q", truncated.String())
+	}
+}
+
+func TestPanelTitleAlign(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tests := []struct {
+		align Align
+		want  string
+	}{
+		{AlignLeft, "│Title     │"},
+		{AlignCenter, "│  Title   │"},
+		{AlignRight, "│     Title│"},
+	}
+
+	for _, tt := range tests {
+		p := New("x").Title("Title").TitleAlign(tt.align).Width(12)
+		lines := strings.Split(p.Render(console, 80).String(), "\n")
+
+		if lines[1] != tt.want {
+			t.Errorf("TitleAlign(%d) title row = %q, want %q", tt.align, lines[1], tt.want)
+		}
+		for i, line := range lines {
+			if rich.DisplayWidth(line) != 12 {
+				t.Errorf("TitleAlign(%d) line %d %q is not 12 columns wide", tt.align, i, line)
+			}
+		}
+	}
+}
+
+func TestPanelTitleInBorder(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tests := []struct {
+		name  string
+		panel *Panel
+		want  string
+	}{
+		{"left", New("x").Title("Title").TitleAlign(AlignLeft), "╭─ Title ─────╮"},
+		{"center", New("x").Title("Title"), "╭─── Title ───╮"},
+		{"right", New("x").Title("Title").TitleAlign(AlignRight), "╭───── Title ─╮"},
+		{"wide", New("x").Title("日本"), "╭─── 日本 ────╮"},
+		{"truncated", New("x").Title("A very long title"), "╭─ A very lo ─╮"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := strings.Split(tt.panel.TitleInBorder(true).Width(15).Render(console, 80).String(), "\n")
+
+			if lines[0] != tt.want {
+				t.Errorf("Top border = %q, want %q", lines[0], tt.want)
+			}
+			if len(lines) != 3 {
+				t.Errorf("Expected no separate title row (3 lines), got %d", len(lines))
+			}
+			for i, line := range lines {
+				if rich.DisplayWidth(line) != 15 {
+					t.Errorf("Line %d %q is not 15 columns wide", i, line)
+				}
+			}
+		})
+	}
+
+	// Too narrow for the title: plain border
+	lines := strings.Split(New("x").Title("T").TitleInBorder(true).Width(5).Padding(0).Render(console, 80).String(), "\n")
+	if lines[0] != "╭───╮" {
+		t.Errorf("Narrow top border = %q, want %q", lines[0], "╭───╮")
+	}
+}
+
+func TestPanelPaddingSides(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	p := New("abc").PaddingSides(2, 3, 1, 4).Width(14)
+	lines := strings.Split(p.Render(console, 80).String(), "\n")
+
+	want := []string{
+		"╭────────────╮",
+		"│            │",
+		"│            │",
+		"│    abc     │",
+		"│            │",
+		"╰────────────╯",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+
+	// Auto-sized panels include asymmetric horizontal padding in their width
+	p = New("abc").PaddingSides(0, 1, 0, 5).Expand(false)
+	lines = strings.Split(p.Render(console, 80).String(), "\n")
+	if lines[1] != "│     abc │" {
+		t.Errorf("Content line = %q, want %q", lines[1], "│     abc │")
+	}
+}
+
+func TestPanelWrapsStringContent(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	p := New("The quick brown fox jumps over the lazy dog").Width(16)
+	lines := strings.Split(p.Render(console, 80).String(), "\n")
+
+	want := []string{
+		"╭──────────────╮",
+		"│ The quick    │",
+		"│ brown fox    │",
+		"│ jumps over   │",
+		"│ the lazy dog │",
+		"╰──────────────╯",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestPanelInGroup(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := table.New().Headers("A", "B").Row("1", "2")
+	group := rich.NewGroup(
+		rich.FillPattern("─", rich.NewStyle()),
+		tbl,
+		New("Done").Width(10),
+	)
+
+	lines := strings.Split(group.Render(console, 20).String(), "\n")
+
+	want := []string{
+		"────────────────────",
+		"┌───┬───┐",
+		"│ A │ B │",
+		"├───┼───┤",
+		"│ 1 │ 2 │",
+		"└───┴───┘",
+		"╭────────╮",
+		"│ Done   │",
+		"╰────────╯",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestPanelsInColumns(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	left := New("CPU 42%").Title("CPU")
+	right := New("Used 3.1G\nFree 4.9G").Title("Memory")
+	cols := rich.NewColumns(left, right).Gap(2)
+
+	lines := strings.Split(cols.Render(console, 40).String(), "\n")
+
+	want := []string{
+		"╭─────────────────╮  ╭─────────────────╮",
+		"│       CPU       │  │     Memory      │",
+		"│ CPU 42%         │  │ Used 3.1G       │",
+		"╰─────────────────╯  │ Free 4.9G       │",
+		"                     ╰─────────────────╯",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+		if w := rich.DisplayWidth(lines[i]); w != 40 {
+			t.Errorf("line %d is %d columns wide, want 40", i, w)
+		}
+	}
+}
+
+func TestPanelCenteredWithAlign(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	p := New("Done").Width(10)
+	aligned := rich.NewAlign(p, rich.AlignCenter, rich.VerticalAlignTop)
+
+	lines := strings.Split(aligned.Render(console, 30).String(), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines, got %d: %q", len(lines), lines)
+	}
+
+	for i, line := range lines {
+		if !strings.HasPrefix(line, strings.Repeat(" ", 10)) || line[10] == ' ' {
+			t.Errorf("line %d %q should have exactly 10 columns of left padding", i, line)
+		}
+		if w := rich.DisplayWidth(line); w != 30 {
+			t.Errorf("line %d is %d columns wide, want 30", i, w)
+		}
+	}
+}
+
+func TestPanelMeasure(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := table.New().Headers("Name", "Role").Row("Alice", "Engineer")
+
+	tests := []struct {
+		name  string
+		panel *Panel
+	}{
+		{"string fit", New("Hello, world").Expand(false)},
+		{"string expand", New("Hello, world")},
+		{"fixed width", New("Hello").Width(30)},
+		{"wide padding", New("Hi").PaddingSides(0, 4, 0, 2).Expand(false)},
+		{"table content", New(tbl).Expand(false)},
+		{"nested panel", New(New("inner").Expand(false)).Expand(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.panel.Measure(console, 60)
+			lines := strings.Split(tt.panel.Render(console, 60).String(), "\n")
+
+			for i, line := range lines {
+				if w := rich.DisplayWidth(line); w != m.Maximum {
+					t.Errorf("line %d %q is %d wide, Measure().Maximum = %d", i, line, w, m.Maximum)
+				}
+			}
+			if m.Minimum > m.Maximum {
+				t.Errorf("Minimum %d exceeds Maximum %d", m.Minimum, m.Maximum)
+			}
+		})
+	}
+
+	// The minimum leaves room for the longest word plus borders and padding
+	m := New("a bb ccc").Measure(console, 60)
+	if m.Minimum != 7 {
+		t.Errorf("Expected minimum 7 (3 + 2 borders + 2 padding), got %d", m.Minimum)
+	}
+}
+
+func TestPanelExpandTabs(t *testing.T) {
+	console := rich.NewConsole(nil)
+	console.ExpandTabs(4)
+
+	p := New("func main() {\n\tfmt.Println()\n}").Expand(false)
+	lines := strings.Split(p.Render(console, 60).String(), "\n")
+
+	if !strings.Contains(lines[2], "│     fmt.Println() │") {
+		t.Errorf("Expected the tab to expand to 4 spaces, got %q", lines[2])
+	}
+	for i, line := range lines {
+		if w := rich.DisplayWidth(line); w != rich.DisplayWidth(lines[0]) {
+			t.Errorf("line %d %q is %d wide, want %d", i, line, w, rich.DisplayWidth(lines[0]))
+		}
+	}
+}
+
+func TestPanelFitWideContent(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	content := "日本語のテキスト\n🚀 launch ✅\nplain"
+	p := New(content).Expand(false)
+
+	m := p.Measure(console, 80)
+	want := rich.DisplayWidth("日本語のテキスト") + 2 + 2
+	if m.Maximum != want {
+		t.Errorf("Expected Measure().Maximum %d (16 + 2 borders + 2 padding), got %d", want, m.Maximum)
+	}
+
+	// Rendering at exactly the measured width must not truncate anything
+	out := p.Render(console, m.Maximum).String()
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.Contains(out, line) {
+			t.Errorf("Expected %q in output, got:\n%s", line, out)
+		}
+	}
+	for i, line := range strings.Split(out, "\n") {
+		if w := rich.DisplayWidth(line); w != m.Maximum {
+			t.Errorf("line %d %q is %d wide, want %d", i, line, w, m.Maximum)
+		}
+	}
+	if strings.Contains(out, "…") {
+		t.Errorf("Expected no truncation, got:\n%s", out)
+	}
+}
@@ -2,6 +2,7 @@ package progress
 
 import (
 	"strings"
+	"time"
 
 	"github.com/eberle1080/go-rich"
 )
@@ -33,29 +34,81 @@ type ProgressBar struct {
 	current int64 // Current progress value
 	total   int64 // Total value for completion
 
-	description string // Text description displayed with the bar
-	width       int    // Bar width in characters (0 = auto)
+	description       string // Text description displayed with the bar
+	markupDescription bool   // Whether the description is parsed as markup
+	width             int    // Bar width in characters (0 = auto)
 
 	// Styles for different parts of the bar
 	barStyle       rich.Style // Style for the bar container
 	completeStyle  rich.Style // Style for completed portion
 	remainingStyle rich.Style // Style for remaining portion
 
+	// Gradient for the completed portion (nil = plain completeStyle)
+	gradientFrom rich.Color // Color at the left end of the bar
+	gradientTo   rich.Color // Color at the right end of the bar
+
 	// Characters used to draw the bar
 	completeChar  string // Character for completed portion (default: "█")
 	remainingChar string // Character for remaining portion (default: "░")
+	leftBracket   string // Drawn before the bar in barStyle (default: none)
+	rightBracket  string // Drawn after the bar in barStyle (default: none)
 
 	// Tracker for speed and ETA calculations
 	tracker *Tracker
+
+	// Indeterminate mode: a pulse band sweeps across the bar instead of a fill
+	indeterminate bool // Whether to render the sweeping pulse
+	pulsePhase    int  // Current pulse position (advanced by Pulse)
+
+	// Animation: the drawn fill eases toward the real progress over several frames
+	animate   bool    // Whether the fill is animated
+	displayed float64 // Fraction currently drawn (0.0-1.0, advanced by Step)
+
+	// Stacked segments drawn in place of the single fill (see AddSegment)
+	stack []stackSegment
+
+	// Percentage display in the default layout
+	percentPrecision int             // Maximum decimal places (default: 1)
+	percentPosition  PercentPosition // Where the percentage is drawn (default: after the bar)
+
+	// Custom column layout (nil = default description/bar/percentage layout)
+	columns []Column
 }
 
+// stackSegment is one colored part of a stacked bar.
+type stackSegment struct {
+	label string     // Name used to update the segment
+	value int64      // Amount of the total this segment covers
+	style rich.Style // Style the segment is drawn in
+}
+
+// PercentPosition selects where a bar's percentage is drawn in the default
+// layout (see ProgressBar.PercentPosition).
+type PercentPosition int
+
+const (
+	// PercentAfter draws the percentage after the bar: "Download ████░░ 50%".
+	PercentAfter PercentPosition = iota
+
+	// PercentBefore draws the percentage before the bar: "Download 50% ████░░".
+	PercentBefore
+
+	// PercentNone hides the percentage: "Download ████░░".
+	PercentNone
+)
+
+// maxPercentPrecision limits PercentPrecision so the scaled value can't overflow.
+const maxPercentPrecision = 6
+
 // NewBar creates a new progress bar with the specified total value.
 // The total represents 100% completion. The bar starts at 0 progress.
+// A total of 0 means there is nothing to do, and the bar renders as complete.
 //
 // Default settings:
 //   - Complete character: "█" (full block)
 //   - Remaining character: "░" (light shade)
 //   - Width: 0 (auto-sized)
+//   - Percentage after the bar, with up to one decimal place
 //   - No styles (uses terminal defaults)
 //
 // Example:
@@ -63,15 +116,16 @@ type ProgressBar struct {
 //	bar := progress.NewBar(1000) // Total of 1000 units
 func NewBar(total int64) *ProgressBar {
 	return &ProgressBar{
-		current:        0,
-		total:          total,
-		width:          0, // Auto-size
-		completeChar:   "█",
-		remainingChar:  "░",
-		barStyle:       rich.NewStyle(),
-		completeStyle:  rich.NewStyle(),
-		remainingStyle: rich.NewStyle(),
-		tracker:        newTracker(),
+		current:          0,
+		total:            total,
+		width:            0, // Auto-size
+		completeChar:     "█",
+		remainingChar:    "░",
+		barStyle:         rich.NewStyle(),
+		completeStyle:    rich.NewStyle(),
+		remainingStyle:   rich.NewStyle(),
+		tracker:          newTracker(),
+		percentPrecision: 1,
 	}
 }
 
@@ -86,6 +140,49 @@ func (pb *ProgressBar) Description(desc string) *ProgressBar {
 	return pb
 }
 
+// MarkupDescription enables or disables parsing the description as markup,
+// so parts of it can be styled: "[bold]Downloading[/] file.txt".
+// Layout uses the width of the text without tags. Descriptions that fail
+// to parse are shown as plain text.
+//
+// Default is false (the description is shown as-is, brackets included).
+//
+// Example:
+//
+//	bar := progress.NewBar(100).
+//		Description("Downloading [cyan]" + rich.EscapeMarkup(name) + "[/]").
+//		MarkupDescription(true)
+func (pb *ProgressBar) MarkupDescription(enabled bool) *ProgressBar {
+	pb.markupDescription = enabled
+	return pb
+}
+
+// descriptionSegments returns the description as styled segments, each
+// layered on top of base. Returns nil for an empty description.
+func (pb *ProgressBar) descriptionSegments(base rich.Style) rich.Segments {
+	if pb.description == "" {
+		return nil
+	}
+	if pb.markupDescription {
+		if segments, err := rich.ParseMarkup(pb.description); err == nil {
+			for i := range segments {
+				segments[i].Style = base.Combine(segments[i].Style)
+			}
+			return segments
+		}
+	}
+	return rich.Segments{{Text: pb.description, Style: base}}
+}
+
+// descriptionWidth returns the display width of the description, excluding
+// markup tags when MarkupDescription is enabled.
+func (pb *ProgressBar) descriptionWidth() int {
+	if pb.markupDescription {
+		return rich.DisplayWidth(rich.StripMarkup(pb.description))
+	}
+	return rich.DisplayWidth(pb.description)
+}
+
 // Width sets the width of the progress bar in characters.
 // If set to 0 (default), the bar will auto-size based on available terminal width.
 //
@@ -97,8 +194,59 @@ func (pb *ProgressBar) Width(width int) *ProgressBar {
 	return pb
 }
 
+// PercentPrecision sets the maximum number of decimal places in the
+// percentage. Trailing zeros are dropped, so with the default of 1 a bar
+// shows "42.5%" but "50%" rather than "50.0%"; 0 always shows whole numbers.
+// Values below 0 are treated as 0 and values above 6 as 6. The precision also
+// applies to a PercentageColumn in a custom layout.
+//
+// Example:
+//
+//	bar := progress.NewBar(100).PercentPrecision(0) // "42%"
+func (pb *ProgressBar) PercentPrecision(n int) *ProgressBar {
+	pb.percentPrecision = min(max(n, 0), maxPercentPrecision)
+	return pb
+}
+
+// PercentPosition sets where the percentage is drawn in the default layout:
+// PercentAfter the bar (default), PercentBefore it, or PercentNone to hide it.
+// An auto-sized bar takes over the space of a hidden percentage.
+// It has no effect on custom column layouts, which place a
+// PercentageColumn themselves.
+//
+// Example:
+//
+//	// "Upload 42% ██████░░░░░░░░"
+//	bar := progress.NewBar(100).
+//		Description("Upload").
+//		PercentPrecision(0).
+//		PercentPosition(progress.PercentBefore)
+func (pb *ProgressBar) PercentPosition(position PercentPosition) *ProgressBar {
+	pb.percentPosition = position
+	return pb
+}
+
+// percentWidth returns the widest the percentage text can be at the bar's
+// precision: "100%", or "99.9%" and longer with decimals.
+func (pb *ProgressBar) percentWidth() int {
+	if pb.percentPrecision == 0 {
+		return 4
+	}
+	return 2 + 1 + pb.percentPrecision + 1 // "99" + "." + decimals + "%"
+}
+
+// percentSpace returns the width the percentage takes in the default layout,
+// including the space separating it from the bar, or 0 if it is hidden.
+// Indeterminate bars draw no percentage, so they reserve no space for one.
+func (pb *ProgressBar) percentSpace() int {
+	if pb.percentPosition == PercentNone || pb.indeterminate {
+		return 0
+	}
+	return pb.percentWidth() + 1
+}
+
 // BarStyle sets the style for the bar container.
-// This style is applied to border characters if using a bordered bar.
+// This style is applied to the bracket characters of a bordered bar (see Brackets).
 //
 // Example:
 //
@@ -119,6 +267,80 @@ func (pb *ProgressBar) CompleteStyle(style rich.Style) *ProgressBar {
 	return pb
 }
 
+// GradientFill draws the completed portion as a color gradient running from
+// from at the left end of the bar to to at the right end. Each character is
+// colored by its position across the whole bar, so the fill shifts toward
+// to as progress grows. The colors replace the CompleteStyle's foreground;
+// its other attributes still apply. Pass nil colors to remove the gradient.
+//
+// Example:
+//
+//	bar := progress.NewBar(total).
+//		Description("Download").
+//		GradientFill(rich.RGB(255, 95, 135), rich.RGB(95, 175, 255))
+func (pb *ProgressBar) GradientFill(from, to rich.Color) *ProgressBar {
+	pb.gradientFrom = from
+	pb.gradientTo = to
+	return pb
+}
+
+// AddSegment turns the bar into a stacked bar and appends a segment to it.
+// A stacked bar draws its segments side by side in place of the single fill,
+// each as wide as its share of the total and in its own style (typically a
+// different color), followed by the remaining portion. This shows composite
+// progress, such as how much has been downloaded and how much of that has
+// been verified, in one bar.
+//
+// The bar's progress is the sum of the segment values, so the percentage,
+// speed, and ETA cover all segments together. Update a segment later with
+// SetSegment, or Progress.SetSegment once the bar is in a Progress manager;
+// the label identifies it. Negative values are treated as 0, and
+// segments beyond the total are cut off at the end of the bar.
+//
+// Example:
+//
+//	bar := progress.NewBar(total).
+//		Description("Sync").
+//		AddSegment("verified", 0, rich.NewStyle().Foreground(rich.Green)).
+//		AddSegment("downloaded", 0, rich.NewStyle().Foreground(rich.Blue))
+//
+//	bar.SetSegment("downloaded", downloaded-verified)
+//	bar.SetSegment("verified", verified)
+func (pb *ProgressBar) AddSegment(label string, value int64, style rich.Style) *ProgressBar {
+	pb.stack = append(pb.stack, stackSegment{label: label, value: max(value, 0), style: style})
+	pb.SetProgress(pb.stackTotal())
+	return pb
+}
+
+// SetSegment sets the value of the stacked segment with the given label and
+// updates the bar's progress to the new sum (see AddSegment). Unknown labels
+// are ignored.
+//
+// This is for standalone bars: it is not synchronized with rendering. For a
+// bar added to a running Progress manager, use Progress.SetSegment instead.
+//
+// Example:
+//
+//	bar.SetSegment("verified", verified)
+func (pb *ProgressBar) SetSegment(label string, value int64) {
+	for i := range pb.stack {
+		if pb.stack[i].label == label {
+			pb.stack[i].value = max(value, 0)
+			pb.SetProgress(pb.stackTotal())
+			return
+		}
+	}
+}
+
+// stackTotal returns the sum of the stacked segment values.
+func (pb *ProgressBar) stackTotal() int64 {
+	var sum int64
+	for _, seg := range pb.stack {
+		sum += seg.value
+	}
+	return sum
+}
+
 // RemainingStyle sets the style for the remaining (incomplete) portion of the bar.
 // This affects the color and formatting of the empty part.
 //
@@ -152,6 +374,134 @@ func (pb *ProgressBar) RemainingChar(char string) *ProgressBar {
 	return pb
 }
 
+// Brackets frames the bar with the given characters, such as "[" and "]"
+// for a classic "[████░░░░]" look. They are drawn in the BarStyle. A fixed
+// Width is the width inside the brackets; an auto-sized bar shrinks to make
+// room for them. Pass empty strings to remove the brackets (the default).
+//
+// Example:
+//
+//	bar := progress.NewBar(100).
+//		Brackets("[", "]").
+//		BarStyle(rich.NewStyle().Dim())
+func (pb *ProgressBar) Brackets(left, right string) *ProgressBar {
+	pb.leftBracket = left
+	pb.rightBracket = right
+	return pb
+}
+
+// bracketWidth returns the combined display width of the Brackets.
+func (pb *ProgressBar) bracketWidth() int {
+	return rich.DisplayWidth(pb.leftBracket) + rich.DisplayWidth(pb.rightBracket)
+}
+
+// Indeterminate switches the bar between determinate and indeterminate mode.
+// In indeterminate mode the bar ignores current/total and instead renders a
+// "pulse" band that sweeps across the bar, advanced by Pulse. Use this when
+// work is happening but the total is not yet known (e.g. connecting to a server),
+// then switch back with Indeterminate(false) once the total is available.
+//
+// The percentage is not displayed while the bar is indeterminate.
+//
+// Example:
+//
+//	bar := progress.NewBar(0).Description("Connecting").Indeterminate(true)
+func (pb *ProgressBar) Indeterminate(indeterminate bool) *ProgressBar {
+	pb.indeterminate = indeterminate
+	return pb
+}
+
+// IsIndeterminate returns true if the bar is in indeterminate (pulsing) mode.
+func (pb *ProgressBar) IsIndeterminate() bool {
+	return pb.indeterminate
+}
+
+// Pulse advances the pulse band of an indeterminate bar by one position.
+// This is called on every refresh by a Progress manager, similar to Spinner.Next.
+// It has no visible effect on determinate bars.
+func (pb *ProgressBar) Pulse() {
+	pb.pulsePhase++
+}
+
+// Animate enables or disables eased animation of the fill.
+// When enabled, a jump in progress (e.g. 0% to 60%) doesn't snap the bar:
+// each Step moves the drawn fill part of the way toward the real value, so
+// coarse updates look smooth. The percentage text always shows the real
+// value, and a complete bar is always drawn full. A Progress manager calls
+// Step on every refresh, and snaps the fill to the real value when a task
+// is completed and when it stops, so the final frame is never mid-animation.
+//
+// Default is false (the fill always matches the current progress).
+//
+// Example:
+//
+//	bar := progress.NewBar(100).Description("Batch").Animate(true)
+func (pb *ProgressBar) Animate(animate bool) *ProgressBar {
+	pb.animate = animate
+	pb.displayed = pb.Percentage()
+	return pb
+}
+
+// IsAnimated returns true if the fill is animated (see Animate).
+func (pb *ProgressBar) IsAnimated() bool {
+	return pb.animate
+}
+
+// animationRate is the fraction of the remaining distance to the real
+// progress that the drawn fill covers on each Step.
+const animationRate = 0.35
+
+// Step advances the animated fill one frame toward the current progress.
+// The fill moves a fixed fraction of the remaining distance, so it eases out
+// as it approaches the target, and snaps to it once it is within half a
+// percent. It has no visible effect on bars without animation.
+func (pb *ProgressBar) Step() {
+	target := pb.Percentage()
+	delta := target - pb.displayed
+	if delta > -0.005 && delta < 0.005 {
+		pb.displayed = target
+		return
+	}
+	pb.displayed += delta * animationRate
+}
+
+// settle ends any animation in progress by snapping the drawn fill to the
+// current progress.
+func (pb *ProgressBar) settle() {
+	pb.displayed = pb.Percentage()
+}
+
+// fillFraction returns the fraction of the bar to draw as complete: the
+// animated value when animation is on and the bar isn't complete, otherwise
+// the real percentage.
+func (pb *ProgressBar) fillFraction() float64 {
+	if pb.animate && !pb.IsComplete() {
+		return pb.displayed
+	}
+	return pb.Percentage()
+}
+
+// Columns sets a custom column layout for the bar.
+// When columns are configured, Render draws each column in order, separated
+// by a single space, instead of the default description/bar/percentage layout.
+// Columns that render nothing (e.g. an empty description) are skipped.
+//
+// Example:
+//
+//	bar := progress.NewBar(1000).
+//		Description("Download").
+//		Columns(
+//			progress.NewDescriptionColumn(),
+//			progress.NewBarColumn(),
+//			progress.NewPercentageColumn(),
+//			progress.NewSpeedColumn(),
+//			progress.NewETAColumn(),
+//		)
+func (pb *ProgressBar) Columns(columns ...Column) *ProgressBar {
+	pb.columns = columns
+	return pb
+}
+
 // SetProgress sets the current progress value and updates the tracker.
 // The value should be between 0 and total (inclusive).
 // Values outside this range are clamped.
@@ -195,16 +545,44 @@ func (pb *ProgressBar) Total() int64 {
 }
 
 // Percentage returns the completion percentage (0.0 to 1.0).
+//
+// A bar with a total of zero (or less) has no work to do, so it is reported
+// as 100% complete rather than stuck at 0%. This keeps bars created for
+// empty work sets from looking stalled. For work whose size isn't known yet,
+// use Indeterminate instead.
 func (pb *ProgressBar) Percentage() float64 {
-	if pb.total == 0 {
-		return 0
+	if pb.total <= 0 {
+		return 1
 	}
 	return float64(pb.current) / float64(pb.total)
 }
 
+// Speed returns the current rate of progress in units per second.
+// Returns 0 until at least two progress updates have been recorded.
+func (pb *ProgressBar) Speed() float64 {
+	return pb.tracker.Speed()
+}
+
+// ETA returns the estimated time remaining until the bar reaches its total,
+// based on the current speed. Returns 0 if the speed is unknown or the bar
+// is complete.
+//
+// Example:
+//
+//	fmt.Printf("ETA %s\n", bar.ETA().Round(time.Second))
+func (pb *ProgressBar) ETA() time.Duration {
+	return pb.tracker.ETA(pb.current, pb.total)
+}
+
+// Elapsed returns the time since the bar was created.
+func (pb *ProgressBar) Elapsed() time.Duration {
+	return pb.tracker.Elapsed()
+}
+
 // IsComplete returns true if progress has reached 100%.
+// A bar with a total of zero is always complete (see Percentage).
 func (pb *ProgressBar) IsComplete() bool {
-	return pb.current >= pb.total
+	return pb.total <= 0 || pb.current >= pb.total
 }
 
 // Render implements rich.Renderable.
@@ -215,45 +593,74 @@ func (pb *ProgressBar) IsComplete() bool {
 //
 //	[description] [filled][empty] percentage%
 //
-// If width is 0 (auto), the bar uses all available space minus description and percentage.
+// with the fill framed by the Brackets, if set, and the percentage moved
+// before the bar or left out according to PercentPosition. If width is 0
+// (auto), the bar uses all available space minus description, brackets, and
+// percentage.
 func (pb *ProgressBar) Render(console *rich.Console, width int) rich.Segments {
+	// Custom column layout replaces the default rendering
+	if len(pb.columns) > 0 {
+		return pb.renderColumns(console)
+	}
+
 	segments := rich.Segments{}
 
 	// Render description if present
 	if pb.description != "" {
-		segments = append(segments, rich.Segment{
-			Text:  pb.description + " ",
-			Style: rich.NewStyle(),
-		})
+		segments = append(segments, pb.descriptionSegments(rich.NewStyle())...)
+		segments = append(segments, rich.Segment{Text: " ", Style: rich.NewStyle()}).Coalesce()
 	}
 
 	// Calculate bar width
 	barWidth := pb.width
 	if barWidth == 0 {
 		// Auto-size: use available width minus description and percentage display
-		descLen := len(pb.description)
+		descLen := pb.descriptionWidth()
 		if descLen > 0 {
 			descLen++ // Account for space
 		}
-		percentLen := 6 // " 100%"
-		barWidth = width - descLen - percentLen
+		percentLen := pb.percentSpace()
+		barWidth = width - descLen - percentLen - pb.bracketWidth()
 		if barWidth < 10 {
 			barWidth = 10 // Minimum bar width
 		}
 	}
 
-	// Calculate fill width based on percentage
-	percentage := pb.Percentage()
-	fillWidth := int(float64(barWidth) * percentage)
-	emptyWidth := barWidth - fillWidth
+	// Indeterminate bars draw the pulse band and no percentage
+	if pb.indeterminate {
+		segments = pb.appendBracket(segments, pb.leftBracket)
+		segments = append(segments, pb.renderPulse(barWidth)...)
+		return pb.appendBracket(segments, pb.rightBracket)
+	}
+
+	percentText := formatPercentagePrecision(pb.Percentage(), pb.percentPrecision)
+	if pb.percentPosition == PercentBefore {
+		segments = append(segments, rich.Segment{
+			Text:  percentText + " ",
+			Style: rich.NewStyle(),
+		})
+	}
+
+	segments = pb.appendBracket(segments, pb.leftBracket)
+
+	// Calculate fill width based on the (possibly animated) fill fraction
+	fillWidth := int(float64(barWidth) * pb.fillFraction())
 
 	// Render completed portion
-	if fillWidth > 0 {
+	switch {
+	case len(pb.stack) > 0 && pb.total > 0:
+		var stacked rich.Segments
+		stacked, fillWidth = pb.renderStackedFill(barWidth)
+		segments = append(segments, stacked...)
+	case fillWidth > 0 && pb.gradientFrom != nil && pb.gradientTo != nil:
+		segments = append(segments, pb.renderGradientFill(fillWidth, barWidth)...)
+	case fillWidth > 0:
 		segments = append(segments, rich.Segment{
 			Text:  strings.Repeat(pb.completeChar, fillWidth),
 			Style: pb.completeStyle,
 		})
 	}
+	emptyWidth := barWidth - fillWidth
 
 	// Render remaining portion
 	if emptyWidth > 0 {
@@ -263,18 +670,136 @@ func (pb *ProgressBar) Render(console *rich.Console, width int) rich.Segments {
 		})
 	}
 
+	segments = pb.appendBracket(segments, pb.rightBracket)
+
 	// Render percentage
-	percentText := " "
-	if percentage >= 0.99995 { // Round to 100% at 99.995%
-		percentText += "100%"
-	} else {
-		percentText += formatPercentage(percentage)
+	if pb.percentPosition == PercentAfter {
+		segments = append(segments, rich.Segment{
+			Text:  " " + percentText,
+			Style: rich.NewStyle(),
+		})
+	}
+
+	return segments
+}
+
+// renderStackedFill renders the segments of a stacked bar across a barWidth
+// bar and returns them with their combined width. Each segment ends where its
+// running total falls on the bar, so rounding never lets the segments drift
+// past the overall progress.
+func (pb *ProgressBar) renderStackedFill(barWidth int) (rich.Segments, int) {
+	var segments rich.Segments
+	var sum int64
+	start := 0
+	for _, seg := range pb.stack {
+		sum = min(sum+seg.value, pb.total)
+		end := int(float64(barWidth) * float64(sum) / float64(pb.total))
+		if end > start {
+			segments = append(segments, rich.Segment{
+				Text:  strings.Repeat(pb.completeChar, end-start),
+				Style: seg.style,
+			})
+		}
+		start = end
+	}
+	return segments, start
+}
+
+// renderGradientFill renders the first fillWidth characters of a barWidth bar
+// with the GradientFill colors. Neighboring characters that get the same
+// color (as happens when the bar is wider than the color range) are merged
+// into one segment.
+func (pb *ProgressBar) renderGradientFill(fillWidth, barWidth int) rich.Segments {
+	colors := rich.Gradient(pb.gradientFrom, pb.gradientTo, barWidth)
+
+	var segments rich.Segments
+	for i := 0; i < fillWidth; {
+		run := 1
+		for i+run < fillWidth && colors[i+run] == colors[i] {
+			run++
+		}
+		segments = append(segments, rich.Segment{
+			Text:  strings.Repeat(pb.completeChar, run),
+			Style: pb.completeStyle.Foreground(colors[i]),
+		})
+		i += run
+	}
+	return segments
+}
+
+// appendBracket appends a bracket segment in the bar style, if bracket is set.
+func (pb *ProgressBar) appendBracket(segments rich.Segments, bracket string) rich.Segments {
+	if bracket == "" {
+		return segments
+	}
+	return append(segments, rich.Segment{Text: bracket, Style: pb.barStyle})
+}
+
+// renderColumns renders the configured columns separated by single spaces.
+// Columns that produce no segments are skipped so they don't leave double spaces.
+func (pb *ProgressBar) renderColumns(console *rich.Console) rich.Segments {
+	segments := rich.Segments{}
+
+	for _, col := range pb.columns {
+		colSegments := col.Render(pb, console)
+		if len(colSegments) == 0 {
+			continue
+		}
+
+		// Separate from the previous column
+		if len(segments) > 0 {
+			segments = append(segments, rich.Segment{Text: " ", Style: rich.NewStyle()})
+		}
+		segments = append(segments, colSegments...)
+	}
+
+	return segments
+}
+
+// renderPulse renders the sweeping band used in indeterminate mode.
+// The band is a quarter of the bar wide (at least 1 character) and starts at
+// pulsePhase, wrapping around the right edge back to the left.
+// Adjacent cells of the same kind are merged into a single segment.
+func (pb *ProgressBar) renderPulse(barWidth int) rich.Segments {
+	pulseWidth := barWidth / 4
+	if pulseWidth < 1 {
+		pulseWidth = 1
+	}
+	start := pb.pulsePhase % barWidth
+
+	var segments rich.Segments
+	runLen := 0
+	runInBand := false
+
+	// flush emits the current run of band or background characters
+	flush := func() {
+		if runLen == 0 {
+			return
+		}
+		if runInBand {
+			segments = append(segments, rich.Segment{
+				Text:  strings.Repeat(pb.completeChar, runLen),
+				Style: pb.completeStyle,
+			})
+		} else {
+			segments = append(segments, rich.Segment{
+				Text:  strings.Repeat(pb.remainingChar, runLen),
+				Style: pb.remainingStyle,
+			})
+		}
+		runLen = 0
 	}
 
-	segments = append(segments, rich.Segment{
-		Text:  percentText,
-		Style: rich.NewStyle(),
-	})
+	for i := 0; i < barWidth; i++ {
+		// Distance from the band start, wrapping around the bar
+		inBand := (i-start+barWidth)%barWidth < pulseWidth
+		if inBand != runInBand {
+			flush()
+			runInBand = inBand
+		}
+		runLen++
+	}
+	flush()
 
 	return segments
 }
@@ -282,19 +807,33 @@ func (pb *ProgressBar) Render(console *rich.Console, width int) rich.Segments {
 // Measure implements rich.Measurable.
 // Returns the size requirements for the progress bar.
 func (pb *ProgressBar) Measure(console *rich.Console, maxWidth int) rich.Measurement {
+	// Custom column layout: sum of column widths plus separators
+	if len(pb.columns) > 0 {
+		size := 0
+		for i, col := range pb.columns {
+			size += col.Width(pb, console)
+			if i > 0 {
+				size++ // Space separator
+			}
+		}
+		return rich.Measurement{Minimum: size, Maximum: size}
+	}
+
 	// Minimum: description + 10 char bar + percentage
-	descLen := len(pb.description)
+	descLen := pb.descriptionWidth()
 	if descLen > 0 {
 		descLen++ // Space after description
 	}
-	minWidth := descLen + 10 + 6 // 10 char min bar, " 100%"
+	percentLen := pb.percentSpace()
+	bracketLen := pb.bracketWidth()
+	minWidth := descLen + bracketLen + 10 + percentLen // 10 char min bar
 
 	// Maximum: use fixed width if set, otherwise prefer 40 chars
 	maxBarWidth := pb.width
 	if maxBarWidth == 0 {
 		maxBarWidth = 40
 	}
-	maxBarWidth = descLen + maxBarWidth + 6
+	maxBarWidth = descLen + bracketLen + maxBarWidth + percentLen
 
 	return rich.Measurement{
 		Minimum: minWidth,
@@ -306,16 +845,31 @@ func (pb *ProgressBar) Measure(console *rich.Console, maxWidth int) rich.Measure
 // Returns a string like "42.5%" with one decimal place.
 // Input p is expected to be 0.0-1.0 (0%-100%).
 func formatPercentage(p float64) string {
-	// Convert to percentage (0.0-1.0 -> 0-100)
-	// Format with one decimal place
-	pct := int(p*1000 + 0.5) // Round to nearest 0.1%
-	whole := pct / 10
-	decimal := pct % 10
+	return formatPercentagePrecision(p, 1)
+}
+
+// formatPercentagePrecision formats a percentage value with at most
+// precision decimal places, dropping trailing zeros: "42.25%", "42.5%", "42%".
+// Input p is expected to be 0.0-1.0 (0%-100%).
+func formatPercentagePrecision(p float64, precision int) string {
+	scale := 1
+	for i := 0; i < precision; i++ {
+		scale *= 10
+	}
+
+	// Convert to percentage (0.0-1.0 -> 0-100) and round to the precision
+	pct := int(p*100*float64(scale) + 0.5)
+	whole := pct / scale
+	decimal := pct % scale
 
 	if decimal == 0 {
 		return formatInt(whole) + "%"
 	}
-	return formatInt(whole) + "." + formatInt(decimal) + "%"
+
+	// Zero-pad the decimals to the precision, then drop trailing zeros
+	digits := formatInt(decimal)
+	digits = strings.Repeat("0", precision-len(digits)) + digits
+	return formatInt(whole) + "." + strings.TrimRight(digits, "0") + "%"
 }
 
 // formatInt converts an integer to a string without importing fmt or strconv.
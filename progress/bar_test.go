@@ -1,7 +1,9 @@
 package progress
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/eberle1080/go-rich"
 )
@@ -182,6 +184,240 @@ func TestFormatPercentage(t *testing.T) {
 	}
 }
 
+func TestFormatPercentagePrecision(t *testing.T) {
+	tests := []struct {
+		value     float64
+		precision int
+		expected  string
+	}{
+		{0.5, 0, "50%"},
+		{0.755, 0, "76%"},
+		{0.755, 1, "75.5%"},
+		{0.7525, 2, "75.25%"},
+		{0.751, 2, "75.1%"},
+		{0.7505, 2, "75.05%"},
+		{1.0, 2, "100%"},
+	}
+
+	for _, tt := range tests {
+		result := formatPercentagePrecision(tt.value, tt.precision)
+		if result != tt.expected {
+			t.Errorf("formatPercentagePrecision(%f, %d): expected '%s', got '%s'", tt.value, tt.precision, tt.expected, result)
+		}
+	}
+}
+
+func TestProgressBarPercentPrecision(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(1000).Width(10).PercentPrecision(0)
+	bar.SetProgress(505)
+
+	if got := bar.Render(console, 80).String(); !strings.HasSuffix(got, " 51%") {
+		t.Errorf("Expected whole percentage ' 51%%', got '%s'", got)
+	}
+
+	bar.SetProgress(500)
+	if got := bar.Render(console, 80).String(); !strings.HasSuffix(got, "░ 50%") {
+		t.Errorf("Expected '50%%' with no decimals, got '%s'", got)
+	}
+
+	// The precision carries over to a percentage column
+	bar.Columns(NewPercentageColumn())
+	if got := bar.Render(console, 80).String(); got != "50%" {
+		t.Errorf("Expected percentage column '50%%', got '%s'", got)
+	}
+}
+
+func TestProgressBarPercentPosition(t *testing.T) {
+	console := rich.NewConsole(nil)
+	newBar := func(position PercentPosition) *ProgressBar {
+		bar := NewBar(100).Description("Upload").PercentPrecision(0).PercentPosition(position)
+		bar.SetProgress(50)
+		return bar
+	}
+
+	before := newBar(PercentBefore).Width(4).Render(console, 80).String()
+	if before != "Upload 50% ██░░" {
+		t.Errorf("Expected 'Upload 50%% ██░░', got '%s'", before)
+	}
+
+	none := newBar(PercentNone).Width(4).Render(console, 80).String()
+	if none != "Upload ██░░" {
+		t.Errorf("Expected 'Upload ██░░', got '%s'", none)
+	}
+
+	// Auto-sized bars leave room for "100%", or use its space when it is hidden
+	for _, position := range []PercentPosition{PercentAfter, PercentBefore, PercentNone} {
+		bar := newBar(position)
+		bar.SetProgress(100)
+		out := bar.Render(console, 40).String()
+		if w := rich.DisplayWidth(out); w != 40 {
+			t.Errorf("position %d: expected width 40, got %d in '%s'", position, w, out)
+		}
+	}
+}
+
+func TestProgressBarMeasurePercent(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tests := []struct {
+		name string
+		bar  *ProgressBar
+	}{
+		{"default", NewBar(1000).PercentPosition(PercentAfter)},
+		{"hidden", NewBar(1000).PercentPosition(PercentNone)},
+		{"before", NewBar(1000).PercentPosition(PercentBefore)},
+		{"precision 3", NewBar(3000).PercentPrecision(3)},
+		{"precision 0", NewBar(1000).PercentPrecision(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Progress with as many decimals as the precision shows, so the
+			// percentage is as wide as it gets ("33.333%", "99.9%", "99%")
+			tt.bar.Description("Sync").Width(20).SetProgress(tt.bar.Total() - 1)
+			if tt.name == "precision 3" {
+				tt.bar.SetProgress(1000)
+			}
+
+			m := tt.bar.Measure(console, 80)
+			if w := rich.DisplayWidth(tt.bar.Render(console, 80).String()); w != m.Maximum {
+				t.Errorf("Rendered width %d, Measure().Maximum = %d", w, m.Maximum)
+			}
+		})
+	}
+}
+
+func TestProgressBarBrackets(t *testing.T) {
+	console := rich.NewConsole(nil)
+	dim := rich.NewStyle().Dim()
+	bar := NewBar(100).Width(8).Brackets("[", "]").BarStyle(dim)
+	bar.SetProgress(50)
+
+	segments := bar.Render(console, 80)
+	if got := segments.String(); got != "[████░░░░] 50%" {
+		t.Errorf("Expected '[████░░░░] 50%%', got '%s'", got)
+	}
+	if segments[0].Text != "[" || !segments[0].Style.Equal(dim) {
+		t.Errorf("Expected dim '[' segment, got %q (%v)", segments[0].Text, segments[0].Style)
+	}
+
+	// The fixed width is inside the brackets
+	bar.PercentPosition(PercentNone)
+	if w := rich.DisplayWidth(bar.Render(console, 80).String()); w != 8+2 {
+		t.Errorf("Expected width 10 (8 + 2 brackets), got %d", w)
+	}
+
+	// Measure includes the brackets: "[███████░] 99.9%" is 8 + 2 + 6 wide
+	measured := NewBar(1000).Width(8).Brackets("[", "]")
+	measured.SetProgress(999)
+	m := measured.Measure(console, 80)
+	if w := rich.DisplayWidth(measured.Render(console, 80).String()); w != m.Maximum || w != 16 {
+		t.Errorf("Expected Measure().Maximum %d to match the rendered width 16, got %d", m.Maximum, w)
+	}
+
+	// Auto-sized bars shrink to fit the brackets in the available width
+	auto := NewBar(1000).Description("Copy").Brackets("⟦", "⟧")
+	auto.SetProgress(999)
+	out := auto.Render(console, 40).String()
+	if !strings.HasPrefix(out, "Copy ⟦█") || !strings.HasSuffix(out, "░⟧ 99.9%") {
+		t.Errorf("Expected bracketed bar, got '%s'", out)
+	}
+	if w := rich.DisplayWidth(out); w != 40 {
+		t.Errorf("Expected width 40, got %d in '%s'", w, out)
+	}
+
+	// Indeterminate bars keep their brackets
+	pulse := NewBar(0).Width(8).Brackets("[", "]").Indeterminate(true).Render(console, 80).String()
+	if !strings.HasPrefix(pulse, "[") || !strings.HasSuffix(pulse, "]") || rich.DisplayWidth(pulse) != 10 {
+		t.Errorf("Expected bracketed 10-wide pulse, got '%s'", pulse)
+	}
+}
+
+func TestProgressBarGradientFill(t *testing.T) {
+	console := rich.NewConsole(nil)
+	from, to := rich.RGB(255, 0, 0), rich.RGB(0, 0, 255)
+	bar := NewBar(100).Width(10).CompleteStyle(rich.NewStyle().Bold()).GradientFill(from, to)
+	bar.SetProgress(100)
+
+	var fill rich.Segments
+	for _, seg := range bar.Render(console, 80) {
+		if strings.Contains(seg.Text, "█") {
+			fill = append(fill, seg)
+		}
+	}
+	if len(fill) != 10 {
+		t.Fatalf("Expected one segment per fill character, got %d", len(fill))
+	}
+
+	first, last := fill[0].Style, fill[len(fill)-1].Style
+	if !rich.ColorEqual(first.FgColor(), from) || !rich.ColorEqual(last.FgColor(), to) {
+		t.Errorf("Expected fill from %v to %v, got %v to %v", from, to, first.FgColor(), last.FgColor())
+	}
+	if !first.IsBold() {
+		t.Errorf("Expected the CompleteStyle attributes to be kept")
+	}
+
+	// A partial fill takes its colors from its position across the whole bar
+	bar.SetProgress(50)
+	var partial rich.Segments
+	for _, seg := range bar.Render(console, 80) {
+		if strings.Contains(seg.Text, "█") {
+			partial = append(partial, seg)
+		}
+	}
+	if len(partial) != 5 || !partial[4].Style.Equal(fill[4].Style) {
+		t.Errorf("Expected the half-full bar to end at the middle color, got %v", partial)
+	}
+	if out := bar.Render(console, 80).String(); out != "█████░░░░░ 50%" {
+		t.Errorf("Expected '█████░░░░░ 50%%', got '%s'", out)
+	}
+}
+
+func TestProgressBarStackedSegments(t *testing.T) {
+	console := rich.NewConsole(nil)
+	green := rich.NewStyle().Foreground(rich.Green)
+	blue := rich.NewStyle().Foreground(rich.Blue)
+	bar := NewBar(100).
+		Width(20).
+		AddSegment("verified", 25, green).
+		AddSegment("downloaded", 35, blue)
+
+	segments := bar.Render(console, 80)
+	if len(segments) < 3 {
+		t.Fatalf("Expected two segment runs and the remainder, got %v", segments)
+	}
+
+	// Adjacent runs of 25% and 35% of 20 characters, then the remaining 40%
+	if segments[0].Text != strings.Repeat("█", 5) || !segments[0].Style.Equal(green) {
+		t.Errorf("Expected 5 green characters, got %q (%v)", segments[0].Text, segments[0].Style)
+	}
+	if segments[1].Text != strings.Repeat("█", 7) || !segments[1].Style.Equal(blue) {
+		t.Errorf("Expected 7 blue characters, got %q (%v)", segments[1].Text, segments[1].Style)
+	}
+	if segments[2].Text != strings.Repeat("░", 8) {
+		t.Errorf("Expected 8 remaining characters, got %q", segments[2].Text)
+	}
+
+	// Progress is the sum of the segments
+	if bar.Current() != 60 {
+		t.Errorf("Expected current 60, got %d", bar.Current())
+	}
+	if out := segments.String(); !strings.HasSuffix(out, " 60%") {
+		t.Errorf("Expected '60%%', got '%s'", out)
+	}
+
+	// Updating a segment moves the boundary; overflow is cut off at the end
+	bar.SetSegment("downloaded", 100)
+	segments = bar.Render(console, 80)
+	if segments[1].Text != strings.Repeat("█", 15) || strings.Contains(segments.String(), "░") {
+		t.Errorf("Expected a full bar of 5 + 15 characters, got %q", segments.String())
+	}
+	if bar.Current() != 100 {
+		t.Errorf("Expected current clamped to 100, got %d", bar.Current())
+	}
+}
+
 func TestFormatInt(t *testing.T) {
 	tests := []struct {
 		value    int
@@ -202,3 +438,210 @@ func TestFormatInt(t *testing.T) {
 		}
 	}
 }
+
+func TestProgressBarIndeterminate(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(0).Width(20).Indeterminate(true)
+
+	if !bar.IsIndeterminate() {
+		t.Fatal("Expected IsIndeterminate()=true")
+	}
+
+	first := bar.Render(console, 80).String()
+	bar.Pulse()
+	second := bar.Render(console, 80).String()
+
+	if first == second {
+		t.Errorf("Expected successive renders to differ, both were '%s'", first)
+	}
+
+	// The bar keeps its configured width and shows no percentage
+	for _, out := range []string{first, second} {
+		if n := len([]rune(out)); n != 20 {
+			t.Errorf("Expected 20 characters, got %d in '%s'", n, out)
+		}
+		if strings.Contains(out, "%") {
+			t.Errorf("Indeterminate bar should not show a percentage: '%s'", out)
+		}
+	}
+
+	// The pulse band moves one position per Pulse
+	if strings.Index(second, "█") != strings.Index(first, "█")+len("░") {
+		t.Errorf("Expected band to move one position: '%s' -> '%s'", first, second)
+	}
+
+	// An auto-sized bar fills the width, with no space kept for a percentage
+	auto := NewBar(0).Description("Reading").Indeterminate(true)
+	if w := auto.Render(console, 40).Width(); w != 40 {
+		t.Errorf("Expected auto-sized indeterminate bar to be 40 wide, got %d", w)
+	}
+	if m := auto.Measure(console, 80); m.Maximum != len("Reading ")+40 {
+		t.Errorf("Expected Measure().Maximum %d, got %d", len("Reading ")+40, m.Maximum)
+	}
+}
+
+func TestProgressBarColumns(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(100).
+		Description("Download").
+		Columns(
+			NewDescriptionColumn(),
+			NewBarColumn().SetWidth(10),
+			NewPercentageColumn(),
+			NewSpeedColumn(),
+			NewETAColumn(),
+		)
+	bar.SetProgress(50)
+
+	out := bar.Render(console, 80).String()
+
+	for _, want := range []string{"Download ", "█████░░░░░", "50%", "it/s", "0s"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain '%s', got '%s'", want, out)
+		}
+	}
+
+	// Empty columns are skipped without leaving a double space
+	bar = NewBar(100).Columns(NewDescriptionColumn(), NewPercentageColumn())
+	if out := bar.Render(console, 80).String(); out != "0%" {
+		t.Errorf("Expected '0%%', got '%s'", out)
+	}
+}
+
+func TestProgressBarMetrics(t *testing.T) {
+	bar := NewBar(100)
+
+	if bar.Speed() != 0 {
+		t.Errorf("Expected speed=0 before any progress, got %f", bar.Speed())
+	}
+
+	// Simulate 50 units of progress over one second
+	start := time.Now().Add(-time.Second)
+	bar.tracker.startTime = start
+	bar.SetProgress(0)
+	bar.tracker.samples[0].timestamp = start
+	bar.SetProgress(50)
+
+	speed := bar.Speed()
+	if speed < 45 || speed > 55 {
+		t.Errorf("Expected speed ~50, got %f", speed)
+	}
+
+	// 50 remaining units at ~50 units/sec
+	eta := bar.ETA()
+	if eta < 900*time.Millisecond || eta > 1100*time.Millisecond {
+		t.Errorf("Expected ETA ~1s, got %v", eta)
+	}
+
+	if bar.Elapsed() < time.Second {
+		t.Errorf("Expected elapsed >= 1s, got %v", bar.Elapsed())
+	}
+
+	bar.SetProgress(100)
+	if bar.ETA() != 0 {
+		t.Errorf("Expected ETA=0 when complete, got %v", bar.ETA())
+	}
+}
+
+func TestProgressBarAnimate(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(100).Width(50).Animate(true)
+
+	if !bar.IsAnimated() {
+		t.Fatal("Expected IsAnimated()=true")
+	}
+
+	// The fill doesn't snap after a jump, but the percentage does
+	bar.SetProgress(60)
+	out := bar.Render(console, 80).String()
+	if n := strings.Count(out, "█"); n != 0 {
+		t.Errorf("Expected no fill before the first step, got %d in '%s'", n, out)
+	}
+	if !strings.HasSuffix(out, " 60%") {
+		t.Errorf("Expected real percentage in '%s'", out)
+	}
+
+	// Each step grows the fill toward the target without overshooting
+	prev := 0
+	for i := 0; i < 30; i++ {
+		bar.Step()
+		fill := strings.Count(bar.Render(console, 80).String(), "█")
+		if fill < prev {
+			t.Fatalf("Step %d: fill shrank from %d to %d", i, prev, fill)
+		}
+		if fill > 30 {
+			t.Fatalf("Step %d: fill %d overshot the target 30", i, fill)
+		}
+		prev = fill
+	}
+	if prev != 30 {
+		t.Errorf("Expected fill to settle at 30, got %d", prev)
+	}
+
+	// Without animation the fill matches progress immediately
+	bar.Animate(false)
+	bar.SetProgress(100)
+	if n := strings.Count(bar.Render(console, 80).String(), "█"); n != 50 {
+		t.Errorf("Expected full bar without animation, got %d", n)
+	}
+}
+
+func TestProgressBarMarkupDescription(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(100).Description("[bold]Downloading[/] file.txt").MarkupDescription(true)
+	bar.SetProgress(50)
+
+	segments := bar.Render(console, 60)
+	if segments[0].Text != "Downloading" || !segments[0].Style.IsBold() {
+		t.Errorf("Expected bold 'Downloading' segment, got %q (%v)", segments[0].Text, segments[0].Style)
+	}
+	if segments[1].Text != " file.txt " || segments[1].Style.IsBold() {
+		t.Errorf("Expected plain ' file.txt ' segment, got %q (%v)", segments[1].Text, segments[1].Style)
+	}
+
+	// The bar is sized from the text without tags: 60 - 21 - 6 = 33
+	out := segments.String()
+	if strings.Contains(out, "[bold]") {
+		t.Errorf("Expected markup tags to be removed, got '%s'", out)
+	}
+	if n := strings.Count(out, "█") + strings.Count(out, "░"); n != 33 {
+		t.Errorf("Expected 33-character bar, got %d in '%s'", n, out)
+	}
+
+	// Markup is off by default, so brackets are shown literally
+	plain := NewBar(100).Description("[bold]x[/]").Width(10).Render(console, 60).String()
+	if !strings.HasPrefix(plain, "[bold]x[/] ") {
+		t.Errorf("Expected literal description, got '%s'", plain)
+	}
+
+	// Description columns use the styled segments and stripped width
+	col := NewDescriptionColumn()
+	if w := col.Width(bar, console); w != 20 {
+		t.Errorf("Expected column width 20, got %d", w)
+	}
+	if got := col.Render(bar, console); len(got) != 2 || !got[0].Style.IsBold() {
+		t.Errorf("Expected styled column segments, got %v", got)
+	}
+}
+
+func TestProgressBarZeroTotal(t *testing.T) {
+	bar := NewBar(0).Width(10)
+
+	if pct := bar.Percentage(); pct != 1.0 {
+		t.Errorf("Expected percentage=1.0 for total=0, got %f", pct)
+	}
+	if !bar.IsComplete() {
+		t.Error("Expected IsComplete()=true for total=0")
+	}
+
+	out := bar.Render(rich.NewConsole(nil), 80).String()
+	if out != "██████████ 100%" {
+		t.Errorf("Expected a full bar at 100%%, got '%s'", out)
+	}
+
+	// Progress updates are clamped and don't change the result
+	bar.Advance(5)
+	if bar.Current() != 0 || bar.Percentage() != 1.0 {
+		t.Errorf("Expected 0 at 100%%, got %d at %f", bar.Current(), bar.Percentage())
+	}
+}
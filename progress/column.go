@@ -45,14 +45,12 @@ func (c *DescriptionColumn) Render(bar *ProgressBar, console *rich.Console) rich
 		return rich.Segments{}
 	}
 
-	return rich.Segments{
-		{Text: bar.description, Style: c.style},
-	}
+	return bar.descriptionSegments(c.style)
 }
 
 // Width implements Column.
 func (c *DescriptionColumn) Width(bar *ProgressBar, console *rich.Console) int {
-	return len(bar.description)
+	return bar.descriptionWidth()
 }
 
 // BarColumn displays the visual progress bar.
@@ -107,8 +105,7 @@ func (c *BarColumn) RemainingStyle(style rich.Style) *BarColumn {
 
 // Render implements Column.
 func (c *BarColumn) Render(bar *ProgressBar, console *rich.Console) rich.Segments {
-	percentage := bar.Percentage()
-	fillWidth := int(float64(c.width) * percentage)
+	fillWidth := int(float64(c.width) * bar.fillFraction())
 	emptyWidth := c.width - fillWidth
 
 	segments := rich.Segments{}
@@ -158,7 +155,7 @@ func (c *PercentageColumn) Style(style rich.Style) *PercentageColumn {
 // Render implements Column.
 func (c *PercentageColumn) Render(bar *ProgressBar, console *rich.Console) rich.Segments {
 	percentage := bar.Percentage()
-	text := formatPercentage(percentage)
+	text := formatPercentagePrecision(percentage, bar.percentPrecision)
 
 	return rich.Segments{
 		{Text: text, Style: c.style},
@@ -167,7 +164,7 @@ func (c *PercentageColumn) Render(bar *ProgressBar, console *rich.Console) rich.
 
 // Width implements Column.
 func (c *PercentageColumn) Width(bar *ProgressBar, console *rich.Console) int {
-	return 5 // "100%"
+	return bar.percentWidth() // "100%", or "99.9%" with the default precision
 }
 
 // SpeedColumn displays the current speed in units per second.
@@ -311,6 +308,39 @@ func (c *TransferSpeedColumn) Width(bar *ProgressBar, console *rich.Console) int
 	return 12 // "999.9 MB/s"
 }
 
+// DownloadColumn displays the completed and total amounts as byte sizes,
+// e.g. "45.2 MB / 100 MB". Use it for bars tracking bytes (file transfers).
+type DownloadColumn struct {
+	style rich.Style
+}
+
+// NewDownloadColumn creates a new download column.
+func NewDownloadColumn() *DownloadColumn {
+	return &DownloadColumn{
+		style: rich.NewStyle(),
+	}
+}
+
+// Style sets the style for the download text.
+func (c *DownloadColumn) Style(style rich.Style) *DownloadColumn {
+	c.style = style
+	return c
+}
+
+// Render implements Column.
+func (c *DownloadColumn) Render(bar *ProgressBar, console *rich.Console) rich.Segments {
+	text := formatBytes(bar.current) + " / " + formatBytes(bar.total)
+
+	return rich.Segments{
+		{Text: text, Style: c.style},
+	}
+}
+
+// Width implements Column.
+func (c *DownloadColumn) Width(bar *ProgressBar, console *rich.Console) int {
+	return 19 // "999.9 MB / 999.9 MB"
+}
+
 // Utility functions for formatting
 
 // formatSpeed formats a speed value with unit.
@@ -320,7 +350,7 @@ func formatSpeed(speed float64, unit string) string {
 	}
 
 	// Format with one decimal place
-	speedInt := int(speed*10+0.5) / 10 // Round to nearest 0.1
+	speedInt := int(speed*10 + 0.5) // Round to nearest 0.1
 	whole := speedInt / 10
 	decimal := speedInt % 10
 
@@ -334,22 +364,43 @@ func formatSpeed(speed float64, unit string) string {
 
 // formatTransferSpeed formats a byte speed with appropriate unit (B/s, KB/s, MB/s, GB/s).
 func formatTransferSpeed(bytesPerSecond float64) string {
-	if bytesPerSecond == 0 {
-		return "0 B/s"
+	return formatByteValue(bytesPerSecond) + "/s"
+}
+
+// byteUnits are the unit labels for byte amounts, each step 1024 times the previous.
+var byteUnits = []string{"B", "KB", "MB", "GB", "TB"}
+
+// formatBytes formats a byte count with appropriate unit (B, KB, MB, GB, TB).
+// Example: 1536 → "1.5 KB", 104857600 → "100 MB".
+func formatBytes(n int64) string {
+	return formatByteValue(float64(n))
+}
+
+// formatByteValue scales a byte value to the largest unit below 1024 and
+// formats it with one decimal place (omitted for whole numbers and values >= 100).
+func formatByteValue(value float64) string {
+	if value == 0 {
+		return "0 B"
 	}
 
-	units := []string{"B/s", "KB/s", "MB/s", "GB/s", "TB/s"}
-	value := bytesPerSecond
 	unitIndex := 0
 
 	// Find appropriate unit
-	for unitIndex < len(units)-1 && value >= 1024 {
+	for unitIndex < len(byteUnits)-1 && value >= 1024 {
 		value /= 1024
 		unitIndex++
 	}
 
 	// Format with one decimal place
-	valueInt := int(value*10+0.5) / 10
+	valueInt := int(value*10 + 0.5) // Round to nearest 0.1
+
+	// Rounding can carry a value up to 1024 (1048575 B is 1023.999 KB),
+	// which is shown in the next unit instead
+	if valueInt >= 10240 && unitIndex < len(byteUnits)-1 {
+		value /= 1024
+		unitIndex++
+		valueInt = int(value*10 + 0.5)
+	}
 	whole := valueInt / 10
 	decimal := valueInt % 10
 
@@ -358,7 +409,7 @@ func formatTransferSpeed(bytesPerSecond float64) string {
 		result += "." + formatInt(decimal)
 	}
 
-	return result + " " + units[unitIndex]
+	return result + " " + byteUnits[unitIndex]
 }
 
 // formatDuration formats a duration for display.
@@ -0,0 +1,86 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/eberle1080/go-rich"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		value    int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1, "1 B"},
+		{1023, "1023 B"},
+		{1024, "1 KB"},
+		{1536, "1.5 KB"},
+		{1024 * 1024, "1 MB"},
+		{1024*1024 - 1, "1 MB"},
+		{1024*1024 - 1024, "1023 KB"},
+		{47395635, "45.2 MB"},
+		{100 * 1024 * 1024, "100 MB"},
+		{1024 * 1024 * 1024, "1 GB"},
+		{5 * 1024 * 1024 * 1024 / 2, "2.5 GB"},
+		{1024 * 1024 * 1024 * 1024, "1 TB"},
+	}
+
+	for _, tt := range tests {
+		result := formatBytes(tt.value)
+		if result != tt.expected {
+			t.Errorf("formatBytes(%d): expected '%s', got '%s'", tt.value, tt.expected, result)
+		}
+	}
+}
+
+func TestFormatTransferSpeed(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{0, "0 B/s"},
+		{512, "512 B/s"},
+		{1536, "1.5 KB/s"},
+		{1024 * 1024, "1 MB/s"},
+	}
+
+	for _, tt := range tests {
+		result := formatTransferSpeed(tt.value)
+		if result != tt.expected {
+			t.Errorf("formatTransferSpeed(%f): expected '%s', got '%s'", tt.value, tt.expected, result)
+		}
+	}
+}
+
+func TestFormatSpeed(t *testing.T) {
+	// The rounded value is in tenths; it used to be divided by 10 twice,
+	// so 12.5 it/s was shown as "1.2 it/s"
+	tests := []struct {
+		value    float64
+		expected string
+	}{
+		{0, "0 it/s"},
+		{12.5, "12.5 it/s"},
+		{3, "3 it/s"},
+		{0.96, "1 it/s"},
+		{150.04, "150 it/s"},
+	}
+
+	for _, tt := range tests {
+		if result := formatSpeed(tt.value, "it"); result != tt.expected {
+			t.Errorf("formatSpeed(%v): expected '%s', got '%s'", tt.value, tt.expected, result)
+		}
+	}
+}
+
+func TestDownloadColumn(t *testing.T) {
+	console := rich.NewConsole(nil)
+	bar := NewBar(100 * 1024 * 1024)
+	bar.SetProgress(47395635)
+
+	result := NewDownloadColumn().Render(bar, console).String()
+	if result != "45.2 MB / 100 MB" {
+		t.Errorf("Expected '45.2 MB / 100 MB', got '%s'", result)
+	}
+}
@@ -1,21 +1,15 @@
 package progress
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/eberle1080/go-rich"
-)
-
-// ANSI control sequences for cursor manipulation
-const (
-	cursorUp   = "\x1b[%dA"   // Move cursor up N lines
-	cursorLeft = "\x1b[1000D" // Move cursor to line start (move left 1000 chars)
-	clearLine  = "\x1b[0K"    // Clear from cursor to end of line
-	hideCursor = "\x1b[?25l"  // Hide cursor
-	showCursor = "\x1b[?25h"  // Show cursor
+	"github.com/eberle1080/go-rich/internal/ansi"
 )
 
 // TaskID identifies a task in the progress manager.
@@ -27,7 +21,12 @@ type Task struct {
 	bar       *ProgressBar // Progress bar (nil for spinners)
 	spinner   *Spinner     // Spinner (nil for bars)
 	startTime time.Time    // When the task started
+	endTime   time.Time    // When the task was completed or failed (zero while running)
 	completed bool         // Whether the task is complete
+	failed    bool         // Whether the task finished with a failure
+
+	onComplete func() // Called once when the task completes (nil = none)
+	notified   bool   // Whether onComplete has been called
 }
 
 // Progress manages live progress updates for multiple tasks.
@@ -54,8 +53,9 @@ type Progress struct {
 	writer  io.Writer     // Direct writer (usually console.Writer())
 
 	tasks   map[TaskID]*Task // Active tasks
+	order   []TaskID         // Task IDs in the order they were added (render order)
 	taskSeq TaskID           // Task ID sequence
-	mu      sync.RWMutex     // Protects tasks map
+	mu      sync.RWMutex     // Protects tasks map and order
 
 	running     bool          // Whether the render loop is running
 	ticker      *time.Ticker  // Ticker for periodic refresh
@@ -64,6 +64,10 @@ type Progress struct {
 
 	transient     bool // Whether to clear progress on completion
 	lastLineCount int  // Number of lines rendered in last update
+
+	overall *ProgressBar // Aggregate bar rendered below the tasks (nil = hidden)
+
+	spinnerElapsed bool // Whether spinner lines end with the task's elapsed time
 }
 
 // New creates a new progress manager.
@@ -114,6 +118,78 @@ func (p *Progress) Transient(transient bool) *Progress {
 	return p
 }
 
+// SpinnerElapsed sets whether spinner tasks show how long they have been
+// running, e.g. "⠋ Building... (12s)". The time is counted from when the task
+// was added and stops when it is completed or failed. Bars are unaffected;
+// use an ElapsedColumn for those.
+//
+// Default is false.
+//
+// Example:
+//
+//	prog := progress.New(console).SpinnerElapsed(true)
+func (p *Progress) SpinnerElapsed(show bool) *Progress {
+	p.spinnerElapsed = show
+	return p
+}
+
+// elapsed returns how long the task has been running, or how long it ran
+// if it has finished.
+func (t *Task) elapsed() time.Duration {
+	if !t.endTime.IsZero() {
+		return t.endTime.Sub(t.startTime)
+	}
+	return time.Since(t.startTime)
+}
+
+// ShowOverall enables an extra line below the tasks showing the combined
+// progress of all bar tasks, labelled with the given description.
+// The aggregate is recomputed on every refresh (see OverallBar).
+//
+// Example:
+//
+//	prog := progress.New(console).ShowOverall("Overall")
+func (p *Progress) ShowOverall(description string) *Progress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.overall = NewBar(0).Description(description)
+	return p
+}
+
+// OverallBar returns a snapshot bar whose current and total values are the
+// sums of all bar tasks. Spinners and indeterminate bars are not included.
+// The returned bar is independent of the manager and can be rendered directly.
+//
+// Thread-safe.
+//
+// Example:
+//
+//	overall := prog.OverallBar()
+//	fmt.Printf("%d/%d steps\n", overall.Current(), overall.Total())
+func (p *Progress) OverallBar() *ProgressBar {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	current, total := p.overallTotals()
+	bar := NewBar(total)
+	bar.SetProgress(current)
+	return bar
+}
+
+// overallTotals sums current and total across all determinate bar tasks.
+// The caller must hold p.mu (read or write).
+func (p *Progress) overallTotals() (current, total int64) {
+	for _, task := range p.tasks {
+		if task.bar == nil || task.bar.IsIndeterminate() {
+			continue
+		}
+		current += task.bar.Current()
+		total += task.bar.Total()
+	}
+	return current, total
+}
+
 // AddBar adds a progress bar task with the given description and total.
 // Returns a TaskID that can be used to update the task's progress.
 //
@@ -126,6 +202,24 @@ func (p *Progress) AddBar(description string, total int64) TaskID {
 	return p.Add(bar)
 }
 
+// AddBarWithColumns adds a progress bar task that renders the given columns
+// instead of the default description/bar/percentage layout.
+// Returns a TaskID that can be used to update the task's progress.
+//
+// Example:
+//
+//	task := prog.AddBarWithColumns("Download", 1000,
+//		progress.NewDescriptionColumn(),
+//		progress.NewBarColumn(),
+//		progress.NewPercentageColumn(),
+//		progress.NewSpeedColumn(),
+//		progress.NewETAColumn(),
+//	)
+func (p *Progress) AddBarWithColumns(description string, total int64, columns ...Column) TaskID {
+	bar := NewBar(total).Description(description).Columns(columns...)
+	return p.Add(bar)
+}
+
 // AddSpinner adds a spinner task with the given description.
 // Returns a TaskID that can be used to control the task.
 //
@@ -159,6 +253,7 @@ func (p *Progress) Add(bar *ProgressBar) TaskID {
 		startTime: time.Now(),
 		completed: false,
 	}
+	p.order = append(p.order, id)
 
 	return id
 }
@@ -184,6 +279,7 @@ func (p *Progress) AddSpinnerWithStyle(spinner *Spinner) TaskID {
 		startTime: time.Now(),
 		completed: false,
 	}
+	p.order = append(p.order, id)
 
 	return id
 }
@@ -198,14 +294,19 @@ func (p *Progress) AddSpinnerWithStyle(spinner *Spinner) TaskID {
 //	prog.Update(task, 750) // Set to 75% of 1000
 func (p *Progress) Update(id TaskID, value int64) {
 	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	task, ok := p.tasks[id]
 	if !ok || task.bar == nil {
+		p.mu.Unlock()
 		return
 	}
 
 	task.bar.SetProgress(value)
+	callback := task.takeCompletionLocked()
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback()
+	}
 }
 
 // Advance increments the progress for a bar task by the given delta.
@@ -218,18 +319,55 @@ func (p *Progress) Update(id TaskID, value int64) {
 //	prog.Advance(task, 10) // Add 10 to current progress
 func (p *Progress) Advance(id TaskID, delta int64) {
 	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	task, ok := p.tasks[id]
 	if !ok || task.bar == nil {
+		p.mu.Unlock()
 		return
 	}
 
 	task.bar.Advance(delta)
+	callback := task.takeCompletionLocked()
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback()
+	}
+}
+
+// SetSegment sets the value of a segment of a stacked bar task (see
+// ProgressBar.AddSegment), updating the bar's progress to the new sum.
+// Use this rather than ProgressBar.SetSegment while the manager is running.
+// This is a no-op for spinner tasks and unknown labels.
+//
+// Thread-safe.
+//
+// Example:
+//
+//	task := prog.Add(progress.NewBar(total).
+//		AddSegment("verified", 0, rich.NewStyle().Foreground(rich.Green)).
+//		AddSegment("downloaded", 0, rich.NewStyle().Foreground(rich.Blue)))
+//	prog.SetSegment(task, "downloaded", n)
+func (p *Progress) SetSegment(id TaskID, label string, value int64) {
+	p.mu.Lock()
+	task, ok := p.tasks[id]
+	if !ok || task.bar == nil {
+		p.mu.Unlock()
+		return
+	}
+
+	task.bar.SetSegment(label, value)
+	callback := task.takeCompletionLocked()
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback()
+	}
 }
 
 // Complete marks a task as completed.
 // Completed tasks remain visible until Stop() is called.
+// Spinner tasks stop animating and show their success glyph, and animated
+// bars snap to their real progress.
 //
 // Thread-safe.
 //
@@ -237,6 +375,99 @@ func (p *Progress) Advance(id TaskID, delta int64) {
 //
 //	prog.Complete(task)
 func (p *Progress) Complete(id TaskID) {
+	p.mu.Lock()
+	task, ok := p.tasks[id]
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+
+	task.completed = true
+	task.endTime = time.Now()
+	if task.spinner != nil {
+		task.spinner.Succeed()
+	}
+	if task.bar != nil {
+		task.bar.settle()
+	}
+	callback := task.takeCompletionLocked()
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback()
+	}
+}
+
+// OnComplete registers fn to be called once when the task completes: when
+// Complete is called for it, or when Update, Advance, or SetSegment brings
+// a bar to its total, whichever happens first. Failed tasks (see Fail) never call it.
+// If the task has already completed, fn is called right away. Registering
+// again replaces the previous callback, and the new one is likewise called
+// once.
+//
+// fn runs on the goroutine that completed the task, after the manager's lock
+// is released, so it may safely call back into the manager, for example to
+// add and start the next step.
+//
+// Thread-safe.
+//
+// Example:
+//
+//	download := prog.AddBar("Download", size)
+//	prog.OnComplete(download, func() {
+//		verify := prog.AddBar("Verify", size)
+//		go runVerify(prog, verify)
+//	})
+func (p *Progress) OnComplete(id TaskID, fn func()) {
+	p.mu.Lock()
+	task, ok := p.tasks[id]
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+
+	task.onComplete = fn
+	task.notified = false
+	callback := task.takeCompletionLocked()
+	p.mu.Unlock()
+
+	if callback != nil {
+		callback()
+	}
+}
+
+// takeCompletionLocked returns the task's OnComplete callback if the task
+// has completed and the callback hasn't been called yet, and marks it as
+// called. A bar counts as complete once it reaches its total, unless it is
+// indeterminate. Returns nil otherwise. The caller must hold p.mu and call
+// the callback after releasing it.
+func (t *Task) takeCompletionLocked() func() {
+	if t.onComplete == nil || t.notified || t.failed {
+		return nil
+	}
+
+	done := t.completed || (t.bar != nil && !t.bar.IsIndeterminate() && t.bar.IsComplete())
+	if !done {
+		return nil
+	}
+
+	t.notified = true
+	return t.onComplete
+}
+
+// Fail marks a task as completed with a failure.
+// Failed tasks remain visible until Stop() is called.
+// Spinner tasks stop animating and show their failure glyph, and animated
+// bars snap to their real progress.
+//
+// Thread-safe.
+//
+// Example:
+//
+//	if err != nil {
+//		prog.Fail(task)
+//	}
+func (p *Progress) Fail(id TaskID) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -246,6 +477,14 @@ func (p *Progress) Complete(id TaskID) {
 	}
 
 	task.completed = true
+	task.failed = true
+	task.endTime = time.Now()
+	if task.spinner != nil {
+		task.spinner.Fail()
+	}
+	if task.bar != nil {
+		task.bar.settle()
+	}
 }
 
 // Remove removes a task from the display.
@@ -261,6 +500,52 @@ func (p *Progress) Remove(id TaskID) {
 	defer p.mu.Unlock()
 
 	delete(p.tasks, id)
+	for i, taskID := range p.order {
+		if taskID == id {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Copy copies from src to dst like io.Copy, tracking the transfer with a new
+// progress bar. The manager is started if it isn't already running; the caller
+// is still responsible for calling Stop().
+//
+// The bar is completed when the copy succeeds and marked failed otherwise.
+// If total is unknown (<= 0), an indeterminate bar is shown instead.
+// Returns the number of bytes copied and the first error encountered.
+//
+// Example:
+//
+//	prog := progress.New(console)
+//	defer prog.Stop()
+//
+//	resp, _ := http.Get(url)
+//	defer resp.Body.Close()
+//	n, err := prog.Copy(file, resp.Body, resp.ContentLength, "Downloading")
+func (p *Progress) Copy(dst io.Writer, src io.Reader, total int64, description string) (int64, error) {
+	var task TaskID
+	if total > 0 {
+		task = p.AddBar(description, total)
+	} else {
+		task = p.Add(NewBar(0).Description(description).Indeterminate(true))
+	}
+
+	p.Start()
+
+	reader := NewReader(src, func(n int) {
+		p.Advance(task, int64(n))
+	})
+
+	written, err := io.Copy(dst, reader)
+	if err != nil {
+		p.Fail(task)
+		return written, err
+	}
+
+	p.Complete(task)
+	return written, nil
 }
 
 // Start begins the live update loop.
@@ -275,29 +560,50 @@ func (p *Progress) Remove(id TaskID) {
 //	// ... update progress ...
 //	prog.Stop()
 func (p *Progress) Start() {
+	p.StartContext(context.Background())
+}
+
+// StartContext begins the live update loop and ties it to the given context.
+// When the context is cancelled, the display is stopped exactly as if Stop()
+// had been called: the ticker is stopped, the final state is rendered (or cleared
+// in transient mode), and the cursor is shown again.
+//
+// Calling Stop() before the context is cancelled is still safe.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	prog.StartContext(ctx)
+//	download(ctx, prog) // Progress stops cleanly if ctx is cancelled
+func (p *Progress) StartContext(ctx context.Context) {
 	p.mu.Lock()
 	if p.running {
 		p.mu.Unlock()
 		return
 	}
 	p.running = true
+
+	// Each run gets its own ticker and stop channel, so a stopped manager
+	// can be started again
+	p.ticker = time.NewTicker(p.refreshRate)
+	p.stopChan = make(chan struct{})
+	ticker, stop := p.ticker, p.stopChan
 	p.mu.Unlock()
 
 	// Hide cursor for cleaner display
-	fmt.Fprint(p.writer, hideCursor)
-
-	// Create ticker for periodic refresh
-	p.ticker = time.NewTicker(p.refreshRate)
+	fmt.Fprint(p.writer, ansi.HideCursor)
 
 	// Start render loop in goroutine
-	go p.renderLoop()
+	go p.renderLoop(ctx, ticker, stop)
 }
 
 // Stop stops the live update loop and performs final cleanup.
 // If transient mode is enabled, clears the progress display.
 // Otherwise, leaves the final state visible.
 //
-// This method blocks until the render loop exits.
+// The manager can be started again afterwards; the next run draws below
+// the final state of this one. Use Reset to also remove the finished tasks.
 //
 // Example:
 //
@@ -309,74 +615,256 @@ func (p *Progress) Stop() {
 		return
 	}
 	p.running = false
+	ticker, stop := p.ticker, p.stopChan
 	p.mu.Unlock()
 
 	// Stop the ticker
-	if p.ticker != nil {
-		p.ticker.Stop()
+	if ticker != nil {
+		ticker.Stop()
 	}
 
 	// Signal the render loop to stop
-	close(p.stopChan)
+	close(stop)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The final frame shows the real progress, not an animation midway
+	for _, task := range p.tasks {
+		if task.bar != nil {
+			task.bar.settle()
+		}
+	}
 
 	// Final render (or clear if transient)
 	if p.transient {
-		p.clear()
+		p.clearLocked()
 	} else {
-		p.render()
+		p.renderLocked()
 		fmt.Fprintln(p.writer) // Move to next line
 	}
 
 	// Show cursor again
-	fmt.Fprint(p.writer, showCursor)
+	fmt.Fprint(p.writer, ansi.ShowCursor)
+
+	// The final state now belongs to the scrollback: a later run must not
+	// move the cursor up over it
+	p.lastLineCount = 0
+}
+
+// Reset stops the manager if it is running and removes all tasks, so it can
+// be reused for a new set of work. Settings such as the refresh rate,
+// transient mode, and the overall bar are kept. Task IDs from before the
+// reset are no longer valid and are ignored by Update, Complete, etc.
+//
+// Example:
+//
+//	for _, phase := range phases {
+//		prog.Reset()
+//		for _, step := range phase.Steps {
+//			prog.AddBar(step.Name, step.Size)
+//		}
+//		prog.Start()
+//		phase.Run(prog)
+//		prog.Stop()
+//	}
+func (p *Progress) Reset() {
+	p.Stop()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tasks = make(map[TaskID]*Task)
+	p.order = nil
+	p.lastLineCount = 0
 }
 
 // renderLoop is the main render loop that runs in a goroutine.
-func (p *Progress) renderLoop() {
+// It exits when Stop() closes this run's stop channel or when ctx is
+// cancelled, in which case it performs the Stop() cleanup itself.
+func (p *Progress) renderLoop(ctx context.Context, ticker *time.Ticker, stop <-chan struct{}) {
 	for {
 		select {
-		case <-p.ticker.C:
-			p.advanceSpinners()
-			p.render()
-		case <-p.stopChan:
+		case <-ticker.C:
+			p.advanceAnimations()
+
+			// A tick can race with Stop; once this run has stopped, its
+			// final frame is already in the scrollback and must not be redrawn
+			p.mu.Lock()
+			if p.running && p.stopChan == stop {
+				p.renderLocked()
+			}
+			p.mu.Unlock()
+		case <-stop:
+			return
+		case <-ctx.Done():
+			// Only stop the run this loop belongs to, not a later restart
+			p.mu.RLock()
+			current := p.running && p.stopChan == stop
+			p.mu.RUnlock()
+			if current {
+				p.Stop()
+			}
 			return
 		}
 	}
 }
 
-// advanceSpinners advances all spinner animations to the next frame.
-func (p *Progress) advanceSpinners() {
+// advanceAnimations advances all spinner animations to the next frame,
+// moves the pulse band of indeterminate bars, and eases animated bars
+// toward their current progress.
+func (p *Progress) advanceAnimations() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	for _, task := range p.tasks {
-		if task.spinner != nil {
+		if task.spinner != nil && !task.spinner.IsFinished() {
 			task.spinner.Next()
 		}
+		if task.bar != nil && task.bar.IsIndeterminate() {
+			task.bar.Pulse()
+		}
+		if task.bar != nil && task.bar.IsAnimated() {
+			task.bar.Step()
+		}
 	}
 }
 
-// render renders all tasks to the console.
-func (p *Progress) render() {
+// Log prints a message above the live progress display.
+// The message scrolls up with normal terminal output while the bars are
+// re-rendered below it, so logging never corrupts the in-place display.
+// Use this instead of printing to the console directly while Progress is running.
+//
+// The content can be either:
+//   - A string: Printed as plain text
+//   - A rich.Renderable: Rendered at the console width (e.g. styled text, tables)
+//   - Any other type: Formatted with fmt.Sprint
+//
+// Thread-safe.
+//
+// Example:
+//
+//	prog.Log("Downloaded file1.txt")
+//	prog.Log(rich.NewRenderableString("warning: retrying", rich.NewStyle().Foreground(rich.Yellow)))
+func (p *Progress) Log(content interface{}) {
+	var segments rich.Segments
+	switch c := content.(type) {
+	case string:
+		segments = rich.Segments{{Text: c, Style: rich.NewStyle()}}
+	case rich.Renderable:
+		segments = c.Render(p.console, p.console.Width())
+	default:
+		segments = rich.Segments{{Text: fmt.Sprint(c), Style: rich.NewStyle()}}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Move cursor up to start of progress area so the message replaces it
+	barsVisible := p.lastLineCount > 0
+	if barsVisible {
+		fmt.Fprint(p.writer, ansi.CursorUpN(p.lastLineCount))
+	}
+
+	// Write the message, clearing each line of old bar content first
+	output := segments.ToANSIWithReset(p.console.ColorMode(), p.console.ResetSequence())
+	fmt.Fprint(p.writer, ansi.LineStart+strings.ReplaceAll(output, "\n", "\n"+ansi.LineStart))
+	fmt.Fprintln(p.writer)
+
+	// Re-render the bars below the message
+	if barsVisible {
+		p.lastLineCount = 0
+		p.renderLocked()
+	}
+}
+
+// Summary returns a one-line summary of the tasks in the manager, such as
+// "3 tasks completed in 12s" or "2 of 3 tasks completed, 1 failed in 4s".
+// Failed tasks are counted as failed, not completed.
+// The elapsed time is the longest time any task has been running.
+// It is typically printed after Stop().
+//
+// Thread-safe.
+//
+// Example:
+//
+//	prog.Stop()
+//	console.Println(prog.Summary())
+func (p *Progress) Summary() rich.Segments {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
+	total := len(p.tasks)
+	completed := 0
+	failed := 0
+	var elapsed time.Duration
+
+	for _, task := range p.tasks {
+		if task.failed {
+			failed++
+		} else if task.completed || (task.bar != nil && !task.bar.IsIndeterminate() && task.bar.IsComplete()) {
+			completed++
+		}
+
+		taskElapsed := task.elapsed()
+		if task.bar != nil {
+			taskElapsed = task.bar.tracker.Elapsed()
+		}
+		if taskElapsed > elapsed {
+			elapsed = taskElapsed
+		}
+	}
+
+	noun := "tasks"
+	if total == 1 {
+		noun = "task"
+	}
+
+	var text string
+	if completed == total {
+		text = formatInt(total) + " " + noun + " completed"
+	} else {
+		text = formatInt(completed) + " of " + formatInt(total) + " " + noun + " completed"
+	}
+	if failed > 0 {
+		text += ", " + formatInt(failed) + " failed"
+	}
+	text += " in " + formatDuration(elapsed)
+
+	return rich.Segments{{Text: text, Style: rich.NewStyle()}}
+}
+
+// render renders all tasks to the console.
+// It takes the write lock, since rendering updates the overall bar and the
+// line count used by the next refresh.
+func (p *Progress) render() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.renderLocked()
+}
+
+// renderLocked renders all tasks to the console, one line each, in the
+// order they were added. The caller must hold p.mu for writing.
+func (p *Progress) renderLocked() {
 	if len(p.tasks) == 0 {
 		return
 	}
 
 	// Move cursor up to start of progress area (if we rendered before)
 	if p.lastLineCount > 0 {
-		fmt.Fprintf(p.writer, cursorUp, p.lastLineCount)
+		fmt.Fprint(p.writer, ansi.CursorUpN(p.lastLineCount))
 	}
 
 	// Render each task
 	lineCount := 0
 	consoleWidth := p.console.Width()
 
-	for _, task := range p.tasks {
+	for _, id := range p.order {
+		task := p.tasks[id]
+
 		// Move to line start and clear
-		fmt.Fprintf(p.writer, "%s%s", cursorLeft, clearLine)
+		fmt.Fprint(p.writer, ansi.LineStart)
 
 		// Render the bar or spinner
 		var segments rich.Segments
@@ -384,11 +872,35 @@ func (p *Progress) render() {
 			segments = task.bar.Render(p.console, consoleWidth)
 		} else if task.spinner != nil {
 			segments = task.spinner.Render(p.console, consoleWidth)
+			if p.spinnerElapsed {
+				segments = append(segments, rich.Segment{
+					Text:  " (" + formatDuration(task.elapsed()) + ")",
+					Style: rich.NewStyle().Dim(),
+				})
+			}
+
+			// Keep the line from wrapping, which would throw off the
+			// cursor movement on the next refresh
+			segments = fitWidth(segments, consoleWidth)
 		}
 
 		// Convert to ANSI and write
-		ansi := segments.ToANSI(p.console.ColorMode())
-		fmt.Fprint(p.writer, ansi)
+		output := segments.ToANSIWithReset(p.console.ColorMode(), p.console.ResetSequence())
+		fmt.Fprint(p.writer, output)
+		fmt.Fprintln(p.writer)
+
+		lineCount++
+	}
+
+	// Render the aggregate bar below the tasks
+	if p.overall != nil {
+		current, total := p.overallTotals()
+		p.overall.total = total
+		p.overall.SetProgress(current)
+
+		fmt.Fprint(p.writer, ansi.LineStart)
+		segments := p.overall.Render(p.console, consoleWidth)
+		fmt.Fprint(p.writer, segments.ToANSIWithReset(p.console.ColorMode(), p.console.ResetSequence()))
 		fmt.Fprintln(p.writer)
 
 		lineCount++
@@ -397,22 +909,44 @@ func (p *Progress) render() {
 	p.lastLineCount = lineCount
 }
 
-// clear clears the progress display (for transient mode).
-func (p *Progress) clear() {
-	if p.lastLineCount == 0 {
-		return
+// fitWidth cuts segments to at most width display columns. If anything is
+// cut, the line ends with an ellipsis in the style of the segment it cuts.
+func fitWidth(segments rich.Segments, width int) rich.Segments {
+	if segments.Width() <= width {
+		return segments
+	}
+	if width < 1 {
+		return nil
 	}
 
-	// Move cursor up to start of progress area
-	fmt.Fprintf(p.writer, cursorUp, p.lastLineCount)
+	// Reserve a column for the ellipsis
+	available := width - 1
+	var result rich.Segments
+	used := 0
+	for _, seg := range segments {
+		segWidth := rich.DisplayWidth(seg.Text)
+		if used+segWidth > available {
+			seg.Text = rich.Truncate(seg.Text, available-used, "") + "…"
+			result = append(result, seg)
+			break
+		}
+		result = append(result, seg)
+		used += segWidth
+	}
+	return result
+}
 
-	// Clear each line
-	for i := 0; i < p.lastLineCount; i++ {
-		fmt.Fprintf(p.writer, "%s%s\n", cursorLeft, clearLine)
+// clearLocked clears the progress display (for transient mode).
+// The caller must hold p.mu for writing.
+func (p *Progress) clearLocked() {
+	if p.lastLineCount == 0 {
+		return
 	}
 
-	// Move cursor back up
-	fmt.Fprintf(p.writer, cursorUp, p.lastLineCount)
+	// Move cursor up to start of progress area, then clear each line and
+	// move back up
+	fmt.Fprint(p.writer, ansi.CursorUpN(p.lastLineCount))
+	fmt.Fprint(p.writer, ansi.ClearLines(p.lastLineCount))
 
 	p.lastLineCount = 0
 }
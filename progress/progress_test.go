@@ -0,0 +1,494 @@
+package progress
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eberle1080/go-rich"
+	"github.com/eberle1080/go-rich/internal/ansi"
+)
+
+// newTestProgress creates a progress manager writing to a buffer with colors disabled.
+func newTestProgress() (*Progress, *bytes.Buffer) {
+	var buf bytes.Buffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+	return New(console), &buf
+}
+
+func TestProgressLog(t *testing.T) {
+	prog, buf := newTestProgress()
+	task := prog.AddBar("Download", 100)
+	prog.Update(task, 50)
+
+	prog.render()
+	buf.Reset()
+
+	prog.Log("hello")
+
+	if prog.lastLineCount != 1 {
+		t.Errorf("Expected lastLineCount=1 after Log, got %d", prog.lastLineCount)
+	}
+
+	out := buf.String()
+
+	// The cursor moves up over the bar area before writing the message
+	if !strings.HasPrefix(out, "\x1b[1A") {
+		t.Errorf("Expected output to start with cursor up, got %q", out)
+	}
+
+	// The message is followed by the re-rendered bar
+	logPos := strings.Index(out, "hello\n")
+	barPos := strings.Index(out, "Download")
+	if logPos < 0 || barPos < 0 || barPos < logPos {
+		t.Errorf("Expected message followed by bar, got %q", out)
+	}
+	if !strings.Contains(out[barPos:], "50%") {
+		t.Errorf("Expected bar content to be preserved, got %q", out)
+	}
+}
+
+func TestProgressLogWithoutBars(t *testing.T) {
+	prog, buf := newTestProgress()
+
+	prog.Log(rich.NewRenderableString("line 1\nline 2", rich.NewStyle()))
+
+	out := buf.String()
+	if strings.Contains(out, "\x1b[1A") {
+		t.Errorf("Did not expect cursor movement without bars, got %q", out)
+	}
+	if !strings.Contains(out, "line 1\n") || !strings.Contains(out, "line 2\n") {
+		t.Errorf("Expected both lines in output, got %q", out)
+	}
+	if prog.lastLineCount != 0 {
+		t.Errorf("Expected lastLineCount=0, got %d", prog.lastLineCount)
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for use by the render loop goroutine and the test.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestProgressStartContextCancel(t *testing.T) {
+	var buf syncBuffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+	prog := New(console).RefreshRate(time.Millisecond)
+	prog.AddBar("Download", 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	prog.StartContext(ctx)
+	cancel()
+
+	// Wait for the render loop to notice the cancellation and restore the cursor
+	deadline := time.Now().Add(2 * time.Second)
+	for !strings.Contains(buf.String(), ansi.ShowCursor) {
+		if time.Now().After(deadline) {
+			t.Fatal("Cursor not restored after context cancellation")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Stop() after cancellation is a no-op
+	prog.Stop()
+
+	out := buf.String()
+	if !strings.HasPrefix(out, ansi.HideCursor) {
+		t.Errorf("Expected output to start with hide cursor, got %q", out)
+	}
+	if !strings.HasSuffix(out, ansi.ShowCursor) {
+		t.Errorf("Expected output to end with show cursor, got %q", out)
+	}
+	if strings.Count(out, ansi.ShowCursor) != 1 {
+		t.Errorf("Expected cursor to be shown exactly once, got %q", out)
+	}
+}
+
+func TestProgressCompleteSpinner(t *testing.T) {
+	prog, buf := newTestProgress()
+	done := prog.AddSpinner("Compile")
+	failed := prog.AddSpinner("Link")
+
+	prog.Complete(done)
+	prog.Fail(failed)
+	prog.advanceAnimations()
+	prog.render()
+
+	out := buf.String()
+	if !strings.Contains(out, "✓ Compile") {
+		t.Errorf("Expected completed spinner to show success glyph, got %q", out)
+	}
+	if !strings.Contains(out, "✗ Link") {
+		t.Errorf("Expected failed spinner to show failure glyph, got %q", out)
+	}
+}
+
+func TestProgressOnComplete(t *testing.T) {
+	prog, _ := newTestProgress()
+	task := prog.AddBar("Download", 100)
+
+	calls := 0
+	prog.OnComplete(task, func() {
+		calls++
+		// The lock is released, so the callback can use the manager
+		prog.AddSpinner("Verify")
+	})
+
+	prog.Advance(task, 60)
+	if calls != 0 {
+		t.Fatalf("Expected no call before the total is reached, got %d", calls)
+	}
+
+	prog.Advance(task, 40)
+	prog.Advance(task, 10)
+	prog.Update(task, 100)
+	prog.Complete(task)
+	if calls != 1 {
+		t.Errorf("Expected exactly one call, got %d", calls)
+	}
+
+	// Complete triggers it for spinners; failed tasks never do
+	spinner := prog.AddSpinner("Compile")
+	failed := prog.AddBar("Upload", 10)
+	spinnerCalls, failedCalls := 0, 0
+	prog.OnComplete(spinner, func() { spinnerCalls++ })
+	prog.OnComplete(failed, func() { failedCalls++ })
+	prog.Complete(spinner)
+	prog.Fail(failed)
+	prog.Update(failed, 10)
+	if spinnerCalls != 1 || failedCalls != 0 {
+		t.Errorf("Expected 1 spinner call and 0 failed calls, got %d and %d", spinnerCalls, failedCalls)
+	}
+
+	// Registering on a task that is already complete calls right away
+	late := 0
+	prog.OnComplete(task, func() { late++ })
+	if late != 1 {
+		t.Errorf("Expected an immediate call for a completed task, got %d", late)
+	}
+}
+
+func TestProgressOverallBar(t *testing.T) {
+	prog, buf := newTestProgress()
+	prog.ShowOverall("Overall")
+
+	task1 := prog.AddBar("One", 100)
+	task2 := prog.AddBar("Two", 300)
+	prog.AddSpinner("Waiting")
+
+	prog.Update(task1, 50)
+	prog.Update(task2, 150)
+
+	overall := prog.OverallBar()
+	if overall.Current() != 200 || overall.Total() != 400 {
+		t.Errorf("Expected 200/400, got %d/%d", overall.Current(), overall.Total())
+	}
+	if overall.Percentage() != 0.5 {
+		t.Errorf("Expected overall percentage 0.5, got %f", overall.Percentage())
+	}
+
+	// The overall line follows the task lines and updates as tasks change
+	prog.Update(task2, 250)
+	prog.render()
+
+	if prog.lastLineCount != 4 {
+		t.Errorf("Expected 4 lines (3 tasks + overall), got %d", prog.lastLineCount)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	last := lines[len(lines)-1]
+	if !strings.Contains(last, "Overall") || !strings.Contains(last, "75%") {
+		t.Errorf("Expected overall line at 75%%, got %q", last)
+	}
+}
+
+func TestProgressSummary(t *testing.T) {
+	prog, _ := newTestProgress()
+
+	task1 := prog.AddBar("One", 10)
+	task2 := prog.AddSpinner("Two")
+
+	prog.Update(task1, 10)
+	prog.Complete(task1)
+	prog.Complete(task2)
+
+	summary := prog.Summary().String()
+	if summary != "2 tasks completed in 0s" {
+		t.Errorf("Expected '2 tasks completed in 0s', got '%s'", summary)
+	}
+
+	task3 := prog.AddBar("Three", 10)
+	prog.Fail(task3)
+	prog.AddBar("Four", 10)
+
+	summary = prog.Summary().String()
+	if !strings.HasPrefix(summary, "2 of 4 tasks completed, 1 failed in ") {
+		t.Errorf("Expected partial summary with failure count, got '%s'", summary)
+	}
+
+	// A failed task is not also counted as completed
+	single, _ := newTestProgress()
+	single.Fail(single.AddBar("Only", 10))
+	summary = single.Summary().String()
+	if summary != "0 of 1 task completed, 1 failed in 0s" {
+		t.Errorf("Expected '0 of 1 task completed, 1 failed in 0s', got '%s'", summary)
+	}
+
+	// A running indeterminate bar is not complete, even with no total
+	pulsing, _ := newTestProgress()
+	pulsing.Add(NewBar(0).Description("Reading").Indeterminate(true))
+	summary = pulsing.Summary().String()
+	if summary != "0 of 1 task completed in 0s" {
+		t.Errorf("Expected '0 of 1 task completed in 0s', got '%s'", summary)
+	}
+}
+
+func TestProgressCopy(t *testing.T) {
+	var out syncBuffer
+	console := rich.NewConsole(&out)
+	console.SetColorMode(rich.ColorModeNone)
+	prog := New(console).RefreshRate(time.Millisecond)
+
+	data := bytes.Repeat([]byte("x"), 10000)
+	var dst bytes.Buffer
+
+	n, err := prog.Copy(&dst, bytes.NewReader(data), int64(len(data)), "Copying")
+	prog.Stop()
+
+	if err != nil {
+		t.Fatalf("Copy returned error: %v", err)
+	}
+	if n != int64(len(data)) {
+		t.Errorf("Expected %d bytes copied, got %d", len(data), n)
+	}
+	if !bytes.Equal(dst.Bytes(), data) {
+		t.Error("Destination does not match source")
+	}
+
+	if len(prog.tasks) != 1 {
+		t.Fatalf("Expected 1 task, got %d", len(prog.tasks))
+	}
+	for _, task := range prog.tasks {
+		if task.bar.Percentage() != 1.0 {
+			t.Errorf("Expected bar at 100%%, got %f", task.bar.Percentage())
+		}
+		if !task.completed || task.failed {
+			t.Errorf("Expected task completed without failure")
+		}
+	}
+
+	if !strings.Contains(out.String(), "100%") {
+		t.Errorf("Expected final render to show 100%%, got %q", out.String())
+	}
+}
+
+func TestProgressSpinnerElapsed(t *testing.T) {
+	prog, buf := newTestProgress()
+	prog.SpinnerElapsed(true)
+	task := prog.AddSpinner("Building...")
+
+	// Simulate a task that started 12 seconds ago
+	prog.tasks[task].startTime = time.Now().Add(-12 * time.Second)
+	prog.render()
+
+	if out := buf.String(); !strings.Contains(out, "Building... (12s)") {
+		t.Errorf("Expected elapsed suffix, got %q", out)
+	}
+
+	// The time stops counting once the task completes
+	prog.Complete(task)
+	prog.tasks[task].endTime = prog.tasks[task].startTime.Add(75 * time.Second)
+	buf.Reset()
+	prog.render()
+
+	if out := buf.String(); !strings.Contains(out, "✓ Building... (1m 15s)") {
+		t.Errorf("Expected frozen elapsed suffix, got %q", out)
+	}
+
+	// Off by default
+	prog2, buf2 := newTestProgress()
+	prog2.AddSpinner("Building...")
+	prog2.render()
+	if out := buf2.String(); strings.Contains(out, "(0s)") {
+		t.Errorf("Did not expect elapsed suffix by default, got %q", out)
+	}
+}
+
+func TestProgressSpinnerElapsedNarrow(t *testing.T) {
+	prog, buf := newTestProgress()
+	prog.console.SetWidth(20)
+	prog.SpinnerElapsed(true)
+	task := prog.AddSpinner("Building a very long target name")
+	prog.tasks[task].startTime = time.Now().Add(-12 * time.Second)
+	prog.render()
+
+	// The line, suffix included, is cut to the console width
+	for _, line := range strings.Split(strings.TrimSuffix(ansi.StripANSI(buf.String()), "\n"), "\n") {
+		if w := rich.DisplayWidth(line); w > 20 {
+			t.Errorf("Line %q is %d columns wide, want at most 20", line, w)
+		}
+	}
+	if out := buf.String(); !strings.Contains(out, "Building a very l…") {
+		t.Errorf("Expected truncated description, got %q", out)
+	}
+}
+
+func TestProgressRenderOrder(t *testing.T) {
+	prog, buf := newTestProgress()
+
+	names := []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo"}
+	ids := make([]TaskID, len(names))
+	for i, name := range names {
+		ids[i] = prog.AddBar(name, 100)
+	}
+	prog.AddSpinner("Foxtrot")
+	prog.Remove(ids[2])
+
+	want := []string{"Alpha", "Bravo", "Delta", "Echo", "Foxtrot"}
+	for frame := 0; frame < 20; frame++ {
+		buf.Reset()
+		prog.render()
+
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		if len(lines) != len(want) {
+			t.Fatalf("Frame %d: expected %d lines, got %d", frame, len(want), len(lines))
+		}
+		for i, line := range lines {
+			if !strings.Contains(line, want[i]) {
+				t.Fatalf("Frame %d: line %d = %q, want %s", frame, i, line, want[i])
+			}
+		}
+	}
+}
+
+func TestProgressRestart(t *testing.T) {
+	var buf syncBuffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+	prog := New(console).RefreshRate(time.Millisecond)
+
+	// Phase one
+	first := prog.AddBar("Phase 1", 10)
+	prog.Start()
+	prog.Update(first, 10)
+	prog.Stop()
+
+	// Phase two reuses the manager after a reset
+	prog.Reset()
+	second := prog.AddBar("Phase 2", 10)
+	prog.Start()
+	prog.Update(second, 5)
+	time.Sleep(5 * time.Millisecond)
+	prog.Stop()
+
+	// Stopping twice is still a no-op
+	prog.Stop()
+
+	out := buf.String()
+	if strings.Count(out, ansi.HideCursor) != 2 || strings.Count(out, ansi.ShowCursor) != 2 {
+		t.Errorf("Expected two hide/show cursor pairs, got %q", out)
+	}
+
+	// The second run draws below the first instead of overwriting it
+	phase2 := out[strings.LastIndex(out, ansi.HideCursor):]
+	if strings.Contains(phase2, "Phase 1") {
+		t.Errorf("Expected reset to remove phase 1 tasks, got %q", phase2)
+	}
+	if strings.Contains(phase2[:strings.Index(phase2, "Phase 2")], "\x1b[1A") {
+		t.Errorf("Expected no cursor movement before the first frame of phase 2, got %q", phase2)
+	}
+	if !strings.Contains(phase2, "50%") {
+		t.Errorf("Expected phase 2 progress, got %q", phase2)
+	}
+
+	// Stale task IDs are ignored
+	prog.Update(first, 3)
+	if len(prog.tasks) != 1 {
+		t.Errorf("Expected 1 task after reset, got %d", len(prog.tasks))
+	}
+}
+
+func TestProgressStopSettlesAnimation(t *testing.T) {
+	var buf syncBuffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+	console.SetWidth(40)
+	prog := New(console).RefreshRate(time.Hour)
+
+	done := prog.Add(NewBar(100).Description("Done").Animate(true))
+	half := prog.Add(NewBar(100).Description("Half").Animate(true))
+	prog.Start()
+	prog.Update(done, 100)
+	prog.Update(half, 50)
+	prog.Stop()
+
+	// The last frame shows the real progress, not where the animation was
+	out := buf.String()
+	last := out[strings.LastIndex(out, "Done"):]
+	lines := strings.Split(last, "\n")
+	if strings.Contains(lines[0], "░") || !strings.Contains(lines[0], "100%") {
+		t.Errorf("Expected a full bar in the final frame, got %q", lines[0])
+	}
+	if full, empty := strings.Count(lines[1], "█"), strings.Count(lines[1], "░"); full == 0 || full < empty-1 || full > empty {
+		t.Errorf("Expected a half-full bar in the final frame, got %q", lines[1])
+	}
+
+	// Completing a task snaps its bar too
+	bar := NewBar(10).Animate(true)
+	task := prog.Add(bar)
+	prog.Update(task, 4)
+	prog.Complete(task)
+	if bar.fillFraction() != 0.4 {
+		t.Errorf("Expected Complete to snap the fill to 0.4, got %f", bar.fillFraction())
+	}
+	// So does failing one
+	failed := NewBar(10).Animate(true)
+	task = prog.Add(failed)
+	prog.Update(task, 7)
+	prog.Fail(task)
+	if failed.fillFraction() != 0.7 {
+		t.Errorf("Expected Fail to snap the fill to 0.7, got %f", failed.fillFraction())
+	}
+}
+
+func TestProgressSetSegment(t *testing.T) {
+	prog, _ := newTestProgress()
+	bar := NewBar(100).
+		AddSegment("verified", 0, rich.NewStyle().Foreground(rich.Green)).
+		AddSegment("downloaded", 0, rich.NewStyle().Foreground(rich.Blue))
+	task := prog.Add(bar)
+
+	calls := 0
+	prog.OnComplete(task, func() { calls++ })
+
+	prog.SetSegment(task, "downloaded", 70)
+	prog.SetSegment(task, "verified", 20)
+	if bar.Current() != 90 || calls != 0 {
+		t.Errorf("Expected 90 without completion, got %d with %d calls", bar.Current(), calls)
+	}
+
+	// Filling the stack completes the task
+	prog.SetSegment(task, "verified", 30)
+	prog.SetSegment(task, "unknown", 5)
+	if bar.Current() != 100 || calls != 1 {
+		t.Errorf("Expected 100 with one completion call, got %d with %d calls", bar.Current(), calls)
+	}
+}
@@ -1,6 +1,9 @@
 package progress
 
-import "io"
+import (
+	"io"
+	"os"
+)
 
 // ProgressReader wraps an io.Reader and calls a callback function
 // with the number of bytes read on each Read() call.
@@ -46,6 +49,82 @@ func NewReader(r io.Reader, callback func(int)) io.Reader {
 	}
 }
 
+// NewAutoReader wraps r like NewReader, and also reports how many bytes
+// remain to be read from it, so a bar can be sized without a separate Stat
+// call. Set the callback with OnRead once the bar's task has been added.
+//
+// The total is found by:
+//   - Stat for an *os.File that is a regular file
+//   - seeking to the end and back for any other io.Seeker
+//
+// In both cases only the bytes after the current read position are counted.
+// The total is -1 if it can't be determined (pipes, sockets, plain readers,
+// or a failed Stat or Seek); the reader is still usable. Since a bar with
+// no total shows as complete, use an indeterminate bar in that case.
+//
+// Example:
+//
+//	file, _ := os.Open("large-file.dat")
+//	defer file.Close()
+//
+//	reader, total := progress.NewAutoReader(file)
+//	bar := progress.NewBar(total)
+//	if total < 0 {
+//		bar = progress.NewBar(0).Indeterminate(true)
+//	}
+//	task := prog.Add(bar.Description("Reading"))
+//	reader.OnRead(func(n int) {
+//		prog.Advance(task, int64(n))
+//	})
+func NewAutoReader(r io.Reader) (*ProgressReader, int64) {
+	return &ProgressReader{reader: r}, readerSize(r)
+}
+
+// OnRead sets the callback called with the number of bytes read after each
+// successful Read(), replacing any callback set before.
+// Returns the reader for method chaining.
+//
+// Example:
+//
+//	reader, total := progress.NewAutoReader(file)
+//	reader.OnRead(func(n int) {
+//		prog.Advance(task, int64(n))
+//	})
+func (pr *ProgressReader) OnRead(callback func(int)) *ProgressReader {
+	pr.callback = callback
+	return pr
+}
+
+// readerSize returns the number of bytes left to read from r, or -1.
+func readerSize(r io.Reader) int64 {
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		return -1
+	}
+
+	current, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return -1
+	}
+
+	if file, ok := r.(*os.File); ok {
+		info, err := file.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		return max(info.Size()-current, 0)
+	}
+
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return -1
+	}
+	if _, err := seeker.Seek(current, io.SeekStart); err != nil {
+		return -1
+	}
+	return max(end-current, 0)
+}
+
 // Read implements io.Reader.
 // Reads from the underlying reader and invokes the callback with the byte count.
 func (pr *ProgressReader) Read(p []byte) (n int, err error) {
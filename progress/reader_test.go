@@ -3,6 +3,8 @@ package progress
 import (
 	"bytes"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -110,3 +112,54 @@ func TestProgressReaderClose(t *testing.T) {
 		t.Error("Expected wrapped reader to support io.Closer")
 	}
 }
+
+func TestNewAutoReader(t *testing.T) {
+	data := []byte("Hello, World!")
+	src := bytes.NewReader(data)
+
+	totalRead := 0
+	reader, total := NewAutoReader(src)
+	reader.OnRead(func(n int) {
+		totalRead += n
+	})
+	if total != int64(len(data)) {
+		t.Errorf("Expected total %d, got %d", len(data), total)
+	}
+
+	// Detecting the size must not move the read position
+	got, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, data) || totalRead != len(data) {
+		t.Errorf("Expected to read %q via callback, got %q (%d bytes)", data, got, totalRead)
+	}
+
+	// Only the remaining bytes are counted
+	src = bytes.NewReader(data)
+	src.Seek(7, io.SeekStart)
+	if _, total := NewAutoReader(src); total != 6 {
+		t.Errorf("Expected remaining total 6, got %d", total)
+	}
+
+	// Plain readers have no known size
+	if _, total := NewAutoReader(bytes.NewBufferString("abc")); total != -1 {
+		t.Errorf("Expected total -1 for a non-seeker, got %d", total)
+	}
+}
+
+func TestNewAutoReaderFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.bin")
+	if err := os.WriteFile(path, make([]byte, 1234), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if _, total := NewAutoReader(file); total != 1234 {
+		t.Errorf("Expected total 1234, got %d", total)
+	}
+}
@@ -26,8 +26,24 @@ type Spinner struct {
 	interval    time.Duration // Time between frame updates
 	style       rich.Style    // Style applied to the spinner
 	description string        // Text description displayed with spinner
+
+	// Final glyphs shown instead of the animation once the spinner finishes
+	state        spinnerState // Running, succeeded, or failed
+	successGlyph string       // Glyph shown on success (default: "✓")
+	successStyle rich.Style   // Style for the success glyph (default: green)
+	failureGlyph string       // Glyph shown on failure (default: "✗")
+	failureStyle rich.Style   // Style for the failure glyph (default: red)
 }
 
+// spinnerState tracks whether a spinner is still animating or has finished.
+type spinnerState int
+
+const (
+	spinnerRunning   spinnerState = iota // Animating through frames
+	spinnerSucceeded                     // Finished successfully, shows the success glyph
+	spinnerFailed                        // Finished with failure, shows the failure glyph
+)
+
 // Predefined spinner styles
 var (
 	// SpinnerDots is a Braille-pattern dots spinner: ⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏
@@ -85,6 +101,11 @@ func NewSpinner(frames []string) *Spinner {
 		interval:    80 * time.Millisecond,
 		style:       rich.NewStyle(),
 		description: "",
+
+		successGlyph: "✓",
+		successStyle: rich.NewStyle().Foreground(rich.Green),
+		failureGlyph: "✗",
+		failureStyle: rich.NewStyle().Foreground(rich.Red),
 	}
 }
 
@@ -125,6 +146,49 @@ func (s *Spinner) Interval(interval time.Duration) *Spinner {
 	return s
 }
 
+// SuccessGlyph sets the glyph and style shown in place of the animation
+// once the spinner has succeeded. Default is a green "✓".
+//
+// Example:
+//
+//	spinner := progress.NewSpinner(progress.SpinnerDots).
+//		SuccessGlyph("✔", rich.NewStyle().Foreground(rich.BrightGreen))
+func (s *Spinner) SuccessGlyph(glyph string, style rich.Style) *Spinner {
+	s.successGlyph = glyph
+	s.successStyle = style
+	return s
+}
+
+// FailureGlyph sets the glyph and style shown in place of the animation
+// once the spinner has failed. Default is a red "✗".
+//
+// Example:
+//
+//	spinner := progress.NewSpinner(progress.SpinnerDots).
+//		FailureGlyph("!", rich.NewStyle().Foreground(rich.Yellow))
+func (s *Spinner) FailureGlyph(glyph string, style rich.Style) *Spinner {
+	s.failureGlyph = glyph
+	s.failureStyle = style
+	return s
+}
+
+// Succeed stops the animation and shows the success glyph.
+// This is called by Progress.Complete for spinner tasks.
+func (s *Spinner) Succeed() {
+	s.state = spinnerSucceeded
+}
+
+// Fail stops the animation and shows the failure glyph.
+// This is called by Progress.Fail for spinner tasks.
+func (s *Spinner) Fail() {
+	s.state = spinnerFailed
+}
+
+// IsFinished returns true if the spinner has succeeded or failed.
+func (s *Spinner) IsFinished() bool {
+	return s.state != spinnerRunning
+}
+
 // Next advances to the next frame in the animation.
 // This should be called periodically (typically by a Progress manager)
 // to animate the spinner.
@@ -145,14 +209,20 @@ func (s *Spinner) CurrentFrame() string {
 // The spinner is rendered as:
 //
 //	[frame] [description]
+//
+// Once the spinner has finished, the frame is replaced by the success or failure glyph.
 func (s *Spinner) Render(console *rich.Console, width int) rich.Segments {
 	segments := rich.Segments{}
 
-	// Render current frame
-	segments = append(segments, rich.Segment{
-		Text:  s.CurrentFrame(),
-		Style: s.style,
-	})
+	// Render current frame, or the final glyph if finished
+	switch s.state {
+	case spinnerSucceeded:
+		segments = append(segments, rich.Segment{Text: s.successGlyph, Style: s.successStyle})
+	case spinnerFailed:
+		segments = append(segments, rich.Segment{Text: s.failureGlyph, Style: s.failureStyle})
+	default:
+		segments = append(segments, rich.Segment{Text: s.CurrentFrame(), Style: s.style})
+	}
 
 	// Render description if present
 	if s.description != "" {
@@ -128,3 +128,26 @@ func TestSpinnerPredefinedStyles(t *testing.T) {
 		}
 	}
 }
+
+func TestSpinnerFinished(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	spinner := NewSpinner([]string{"A", "B"}).Description("Build")
+	spinner.Succeed()
+
+	segments := spinner.Render(console, 80)
+	if segments.String() != "✓ Build" {
+		t.Errorf("Expected '✓ Build', got '%s'", segments.String())
+	}
+	if segments[0].Style.FgColor() != rich.Green {
+		t.Error("Expected success glyph to be green")
+	}
+
+	spinner = NewSpinner([]string{"A", "B"}).FailureGlyph("!", rich.NewStyle().Bold())
+	spinner.Fail()
+
+	segments = spinner.Render(console, 80)
+	if segments.String() != "!" || !segments[0].Style.IsBold() {
+		t.Errorf("Expected bold '!', got '%s'", segments.String())
+	}
+}
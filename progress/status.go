@@ -0,0 +1,65 @@
+package progress
+
+import "github.com/eberle1080/go-rich"
+
+// Status shows a spinner with a message while work is in progress, and
+// erases it when the work is done. It is a one-line shorthand for a
+// transient Progress with a single spinner task.
+//
+// Create one with NewStatus, which starts the spinner right away.
+//
+// Example:
+//
+//	status := progress.NewStatus(console, "Connecting...")
+//	defer status.Stop()
+//
+//	conn := connect()
+//	status.Update("Fetching records...")
+//	records := conn.Fetch()
+type Status struct {
+	progress *Progress // Transient manager drawing the spinner
+	task     TaskID    // The spinner task
+}
+
+// NewStatus starts a spinner showing message on console and returns a
+// handle to update or stop it. The spinner is transient: Stop erases it,
+// leaving no trace in the output. Deferring Stop right after NewStatus
+// ensures it is erased however the surrounding function returns.
+//
+// Example:
+//
+//	status := progress.NewStatus(console, "Building...")
+//	defer status.Stop()
+//	build()
+func NewStatus(console *rich.Console, message string) *Status {
+	prog := New(console).Transient(true)
+	s := &Status{
+		progress: prog,
+		task:     prog.AddSpinner(message),
+	}
+	prog.Start()
+	return s
+}
+
+// Update changes the message shown next to the spinner.
+// The new message appears on the next refresh.
+//
+// Thread-safe.
+//
+// Example:
+//
+//	status.Update(fmt.Sprintf("Processing %d of %d...", i, total))
+func (s *Status) Update(message string) {
+	s.progress.mu.Lock()
+	defer s.progress.mu.Unlock()
+
+	if task, ok := s.progress.tasks[s.task]; ok {
+		task.spinner.Description(message)
+	}
+}
+
+// Stop stops the spinner and erases it.
+// Calling Stop more than once is safe.
+func (s *Status) Stop() {
+	s.progress.Stop()
+}
@@ -0,0 +1,60 @@
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eberle1080/go-rich"
+	"github.com/eberle1080/go-rich/internal/ansi"
+)
+
+func TestStatus(t *testing.T) {
+	var buf syncBuffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+
+	status := NewStatus(console, "Connecting...")
+	status.progress.render()
+
+	status.Update("Fetching records...")
+	status.progress.render()
+	status.Stop()
+	status.Stop()
+
+	out := buf.String()
+	if !strings.Contains(out, "Connecting...") || !strings.Contains(out, "Fetching records...") {
+		t.Errorf("Expected both messages to be shown, got %q", out)
+	}
+
+	// Transient: the spinner line is cleared and the cursor returns to it,
+	// so later output overwrites it
+	wantEnd := ansi.CursorUpN(1) + ansi.LineStart + "\n" + ansi.CursorUpN(1) + ansi.ShowCursor
+	if !strings.HasSuffix(out, wantEnd) {
+		t.Errorf("Expected the spinner to be erased, got %q", out)
+	}
+	if strings.Count(out, ansi.ShowCursor) != 1 {
+		t.Errorf("Expected cursor to be shown exactly once, got %q", out)
+	}
+	if status.progress.lastLineCount != 0 {
+		t.Errorf("Expected no lines left, got %d", status.progress.lastLineCount)
+	}
+}
+
+func TestStatusRefreshes(t *testing.T) {
+	var buf syncBuffer
+	console := rich.NewConsole(&buf)
+	console.SetColorMode(rich.ColorModeNone)
+
+	status := NewStatus(console, "Working...")
+	defer status.Stop()
+
+	// The spinner is drawn by the refresh loop without any explicit render
+	deadline := time.Now().Add(2 * time.Second)
+	for !strings.Contains(buf.String(), "Working...") {
+		if time.Now().After(deadline) {
+			t.Fatal("Status was never rendered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,122 @@
+package rich
+
+import (
+	"html"
+	"strings"
+
+	"github.com/eberle1080/go-rich/internal/ansi"
+)
+
+// Record turns recording on or off. While recording, everything the console
+// writes is also kept, with its styles, so it can be exported afterwards with
+// ExportRecordedText or ExportRecordedHTML. Output still goes to the writer
+// as usual, so a program can draw to the terminal and capture the same output
+// for documentation or logs.
+//
+// Turning recording off keeps what was recorded so far; turning it back on
+// appends to it. Output written through Writer() directly is not recorded.
+//
+// Example:
+//
+//	console.Record(true)
+//	console.Render(tbl)
+//	console.Success("Done")
+//	os.WriteFile("screenshot.html", []byte(console.ExportRecordedHTML()), 0o644)
+func (c *Console) Record(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.recording = enabled
+}
+
+// ClearRecorded discards everything recorded so far.
+// Recording stays on or off as it was.
+func (c *Console) ClearRecorded() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.recorded = nil
+}
+
+// ExportRecordedText returns the recorded output as plain text, without any
+// styling or escape sequences.
+//
+// Example:
+//
+//	os.WriteFile("output.txt", []byte(console.ExportRecordedText()), 0o644)
+func (c *Console) ExportRecordedText() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return ansi.StripANSI(c.recorded.String())
+}
+
+// ExportRecordedHTML returns the recorded output as a standalone HTML page.
+// The text is placed in a <pre> block, and each styled run becomes a <span>
+// with inline CSS, so the page needs no stylesheet. Palette colors are
+// converted with ToRGB.
+//
+// Example:
+//
+//	os.WriteFile("screenshot.html", []byte(console.ExportRecordedHTML()), 0o644)
+func (c *Console) ExportRecordedHTML() string {
+	c.mu.Lock()
+	segments := c.recorded.Coalesce()
+	c.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n</head>\n<body>\n")
+	b.WriteString(`<pre style="font-family: Menlo, 'DejaVu Sans Mono', Consolas, monospace">`)
+	for _, seg := range segments {
+		text := html.EscapeString(ansi.StripANSI(seg.Text))
+		if css := styleCSS(seg.Style); css != "" {
+			b.WriteString(`<span style="` + css + `">` + text + "</span>")
+		} else {
+			b.WriteString(text)
+		}
+	}
+	b.WriteString("</pre>\n</body>\n</html>\n")
+	return b.String()
+}
+
+// styleCSS returns the inline CSS declarations for a style, or "" for a
+// plain style. Reverse video swaps whichever colors are set.
+func styleCSS(s Style) string {
+	fg, bg := s.fg, s.bg
+	if s.reverse {
+		fg, bg = bg, fg
+	}
+
+	var decls []string
+	if fg != nil {
+		decls = append(decls, "color: "+ToRGB(fg).String())
+	}
+	if bg != nil {
+		decls = append(decls, "background-color: "+ToRGB(bg).String())
+	}
+	if s.bold {
+		decls = append(decls, "font-weight: bold")
+	}
+	if s.italic {
+		decls = append(decls, "font-style: italic")
+	}
+	if s.dim {
+		decls = append(decls, "opacity: 0.7")
+	}
+
+	var decorations []string
+	if s.underline {
+		decorations = append(decorations, "underline")
+	}
+	if s.strikethrough {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		decls = append(decls, "text-decoration: "+strings.Join(decorations, " "))
+	}
+	if s.underline && s.ul != nil {
+		decls = append(decls, "text-decoration-color: "+ToRGB(s.ul).String())
+	}
+
+	return strings.Join(decls, "; ")
+}
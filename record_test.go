@@ -0,0 +1,70 @@
+package rich
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConsoleRecord(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	console.Println("not recorded")
+	console.Record(true)
+	console.Println("plain line")
+	console.PrintMarkupln("[bold red]Error:[/] <disk> full")
+	console.Renderln(NewRenderableString("rendered", NewStyle().Italic()))
+	console.Record(false)
+	console.Println("after")
+
+	want := "plain line\nError: <disk> full\nrendered\n"
+	if got := console.ExportRecordedText(); got != want {
+		t.Errorf("ExportRecordedText() = %q, want %q", got, want)
+	}
+
+	// The recording is exactly what was written while it was on
+	if out := buf.String(); !strings.Contains(out, want) {
+		t.Errorf("Expected written output to contain %q, got %q", want, out)
+	}
+
+	page := console.ExportRecordedHTML()
+	for _, fragment := range []string{
+		"<pre",
+		`<span style="color: #aa0000; font-weight: bold">Error:</span> &lt;disk&gt; full`,
+		`<span style="font-style: italic">rendered</span>`,
+		"plain line\n",
+	} {
+		if !strings.Contains(page, fragment) {
+			t.Errorf("Expected HTML to contain %q, got:\n%s", fragment, page)
+		}
+	}
+	if strings.Contains(page, "not recorded") || strings.Contains(page, "after") {
+		t.Errorf("Expected only output written while recording, got:\n%s", page)
+	}
+
+	console.ClearRecorded()
+	if got := console.ExportRecordedText(); got != "" {
+		t.Errorf("Expected empty recording after ClearRecorded, got %q", got)
+	}
+}
+
+func TestStyleCSS(t *testing.T) {
+	tests := []struct {
+		style Style
+		want  string
+	}{
+		{NewStyle(), ""},
+		{NewStyle().Foreground(RGB(1, 2, 3)).Background(ANSI256Color(21)), "color: #010203; background-color: #0000ff"},
+		{NewStyle().Underline().Strikethrough(), "text-decoration: underline line-through"},
+		{NewStyle().Reverse().Foreground(Red), "background-color: #aa0000"},
+		{NewStyle().Dim(), "opacity: 0.7"},
+	}
+
+	for _, tt := range tests {
+		if got := styleCSS(tt.style); got != tt.want {
+			t.Errorf("styleCSS(%v) = %q, want %q", tt.style, got, tt.want)
+		}
+	}
+}
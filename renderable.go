@@ -1,5 +1,7 @@
 package rich
 
+import "strings"
+
 // Renderable is the interface for objects that can be rendered to the console.
 // Renderables convert themselves into a series of styled segments that can be
 // displayed, taking into account the available width.
@@ -41,6 +43,22 @@ type Measurable interface {
 	Measure(console *Console, maxWidth int) Measurement
 }
 
+// StreamRenderable is the interface for renderables that can produce their
+// output incrementally. Instead of building one large Segments slice, the
+// renderable calls emit with each chunk (typically one line) as soon as it's
+// ready, so very large content can be written without holding all of it in
+// memory.
+//
+// This is an optional interface - see Console.RenderStreamable. Chunks are
+// concatenated in order; a chunk that starts a new line should begin with
+// its own "\n" segment.
+type StreamRenderable interface {
+	// RenderStream renders the content for the given width, passing each
+	// chunk of segments to emit in order. Chunks must not be retained or
+	// modified by emit after it returns.
+	RenderStream(console *Console, width int, emit func(Segments))
+}
+
 // RenderableString is a simple string that implements Renderable and Measurable.
 // This is a basic implementation used internally, primarily for wrapping plain
 // strings in a Renderable interface.
@@ -68,22 +86,34 @@ func NewRenderableString(text string, style Style) *RenderableString {
 
 // Render implements Renderable.
 // Returns a single segment containing the text with the associated style.
-// The width parameter is ignored since the text is returned as-is.
+// The width parameter is ignored since the text is returned as-is, apart from
+// tab expansion when the console has it enabled (see Console.ExpandTabs).
 func (r *RenderableString) Render(console *Console, width int) Segments {
-	return Segments{{Text: r.Text, Style: r.Style}}
+	return Segments{{Text: r.text(console), Style: r.Style}}
+}
+
+// text returns the text as it will be rendered on console, with tabs
+// expanded if the console has a tab size set.
+func (r *RenderableString) text(console *Console) string {
+	if console == nil || console.tabSize <= 0 || !strings.Contains(r.Text, "\t") {
+		return r.Text
+	}
+	return Segments{{Text: r.Text}}.ExpandTabs(console.tabSize).String()
 }
 
 // Measure implements Measurable.
-// Returns the byte length of the text as both minimum and maximum width.
-// Note: This uses byte length, not rune count, which may not be accurate
-// for multi-byte Unicode characters. This is a known limitation of the
-// current implementation.
+// Widths are in display columns, so wide characters count as two:
+//   - Minimum: the width of the longest word (the text can wrap at spaces)
+//   - Maximum: the width of the longest line
 func (r *RenderableString) Measure(console *Console, maxWidth int) Measurement {
-	length := len(r.Text)
-	return Measurement{
-		Minimum: length,
-		Maximum: length,
+	var m Measurement
+	for _, line := range strings.Split(r.text(console), "\n") {
+		m.Maximum = max(m.Maximum, DisplayWidth(line))
+		for _, word := range strings.Fields(line) {
+			m.Minimum = max(m.Minimum, DisplayWidth(word))
+		}
 	}
+	return m
 }
 
 // Lines is a renderable that represents multiple lines of content.
@@ -80,3 +80,12 @@ func TestLinesSingle(t *testing.T) {
 		t.Errorf("Expected 'Single', got %q", output)
 	}
 }
+
+func TestRenderableStringMeasureMultiline(t *testing.T) {
+	rs := NewRenderableString("short\na longer line\n日本語", NewStyle())
+
+	m := rs.Measure(NewConsole(nil), 100)
+	if m.Minimum != 6 || m.Maximum != 13 {
+		t.Errorf("Expected {6 13}, got %+v", m)
+	}
+}
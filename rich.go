@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/eberle1080/go-rich/internal/ansi"
 	"golang.org/x/term"
@@ -21,14 +23,28 @@ import (
 //   - Segment output (PrintSegments, PrintSegmentsln)
 //   - Renderable output (Render, Renderln)
 //   - Horizontal rules (Rule)
+//   - Themed status messages (Error, Warn, Info, Success)
 //
 // The Console automatically detects terminal color capabilities and terminal
 // dimensions, but both can be overridden if needed.
+//
+// Output methods are safe for concurrent use: each call is written as a
+// single unit, so lines printed from different goroutines never interleave.
+// Configuration setters (SetWidth, SetColorMode, ...) are not synchronized
+// and should be called before output starts.
 type Console struct {
 	writer    io.Writer // Underlying writer (usually os.Stdout)
 	colorMode ColorMode // Detected or explicitly set color mode
 	width     int       // Terminal width in characters
 	height    int       // Terminal height in characters
+	rtl       bool      // Default text direction is right-to-left
+	tabSize   int       // Tab stop interval for expanding tabs (0 = tabs left as-is)
+	theme     Theme     // Styles for Error/Warn/Info/Success messages
+	resetSeq  string    // Written after styled segments (empty = DefaultResetSequence)
+
+	mu        sync.Mutex // Serializes writes so concurrent calls don't interleave, and guards width/height
+	recording bool       // Whether written output is kept for export (see Record)
+	recorded  Segments   // Output written while recording
 }
 
 // NewConsole creates a new Console writing to the specified writer.
@@ -37,6 +53,7 @@ type Console struct {
 // The console automatically:
 //   - Detects color mode based on environment variables and terminal capabilities
 //   - Queries terminal dimensions if the writer is a terminal
+//   - Falls back to the COLUMNS and LINES environment variables
 //   - Falls back to 80×24 if dimensions cannot be determined
 //
 // Example:
@@ -59,19 +76,46 @@ func NewConsole(writer io.Writer) *Console {
 	console := &Console{
 		writer:    writer,
 		colorMode: detectColorMode(writer),
-		width:     80, // Default terminal width
-		height:    24, // Default terminal height
+		theme:     DefaultTheme(),
 	}
+	console.width, console.height = detectSize(writer, 80, 24) // Default 80×24
+
+	return console
+}
 
+// detectSize determines the terminal dimensions for the writer.
+// The terminal is queried if the writer is a terminal file; otherwise the
+// COLUMNS and LINES environment variables are used. Dimensions that can't be
+// determined fall back to the given defaults.
+func detectSize(writer io.Writer, defaultWidth, defaultHeight int) (width, height int) {
 	// Try to get actual terminal size if writer is a terminal file
 	if f, ok := writer.(*os.File); ok {
 		if w, h, err := term.GetSize(int(f.Fd())); err == nil {
-			console.width = w
-			console.height = h
+			return w, h
 		}
 	}
 
-	return console
+	width, height = defaultWidth, defaultHeight
+
+	// Fall back to COLUMNS/LINES (set by many shells and CI pseudo-terminals)
+	if w, ok := sizeFromEnv("COLUMNS"); ok {
+		width = w
+	}
+	if h, ok := sizeFromEnv("LINES"); ok {
+		height = h
+	}
+
+	return width, height
+}
+
+// sizeFromEnv reads a terminal dimension from the named environment variable.
+// Returns false if the variable is unset or not a positive integer.
+func sizeFromEnv(name string) (int, bool) {
+	n, err := strconv.Atoi(strings.TrimSpace(os.Getenv(name)))
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n, true
 }
 
 // detectColorMode determines the color support level of the terminal.
@@ -80,19 +124,32 @@ func NewConsole(writer io.Writer) *Console {
 //  1. NO_COLOR environment variable → ColorModeNone
 //     Respects the NO_COLOR standard (https://no-color.org/)
 //
-//  2. Non-terminal writer → ColorModeNone
+//  2. FORCE_COLOR=0 or FORCE_COLOR=false → ColorModeNone
+//
+//  3. FORCE_COLOR or CLICOLOR_FORCE set → at least the forced mode,
+//     even for non-terminal writers (e.g. CI logs):
+//     FORCE_COLOR=1/true/empty → ColorModeStandard, 2 → ColorMode256,
+//     3 → ColorModeTrueColor; CLICOLOR_FORCE (not "0") → ColorModeStandard.
+//     If the terminal supports more, the detected mode is used.
+//
+//  4. Non-terminal writer → ColorModeNone
 //     Colors are disabled when writing to files, pipes, etc.
 //
-//  3. COLORTERM=truecolor or COLORTERM=24bit → ColorModeTrueColor
+//  5. CLICOLOR=0 → ColorModeNone
+//
+//  6. Platform-specific detection (Windows only)
+//     Enables ANSI processing on the console; Windows Terminal gets TrueColor
+//
+//  7. COLORTERM=truecolor or COLORTERM=24bit → ColorModeTrueColor
 //     Most modern terminals set this for 24-bit RGB support
 //
-//  4. TERM contains "256color" → ColorMode256
+//  8. TERM contains "256color" → ColorMode256
 //     Common values: xterm-256color, screen-256color
 //
-//  5. TERM is set and not "dumb" → ColorModeStandard
+//  9. TERM is set and not "dumb" → ColorModeStandard
 //     Fallback to 16-color ANSI for basic terminals
 //
-//  6. Otherwise → ColorModeNone
+//  10. Otherwise → ColorModeNone
 //     Unknown or dumb terminals get no colors
 func detectColorMode(w io.Writer) ColorMode {
 	// Check NO_COLOR environment variable (https://no-color.org/)
@@ -101,6 +158,46 @@ func detectColorMode(w io.Writer) ColorMode {
 		return ColorModeNone
 	}
 
+	forced, isForced := forcedColorMode()
+	if isForced && forced == ColorModeNone {
+		return ColorModeNone
+	}
+
+	detected := detectTerminalColorMode(w)
+	if isForced && forced > detected {
+		return forced
+	}
+	return detected
+}
+
+// forcedColorMode reads FORCE_COLOR and CLICOLOR_FORCE.
+// Returns false if neither variable requests a specific mode.
+// FORCE_COLOR=0 (or "false") returns ColorModeNone to force colors off.
+func forcedColorMode() (ColorMode, bool) {
+	if value, ok := os.LookupEnv("FORCE_COLOR"); ok {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "0", "false":
+			return ColorModeNone, true
+		case "2":
+			return ColorMode256, true
+		case "3":
+			return ColorModeTrueColor, true
+		default:
+			// "", "1", "true", or any other value
+			return ColorModeStandard, true
+		}
+	}
+
+	if value := os.Getenv("CLICOLOR_FORCE"); value != "" && value != "0" {
+		return ColorModeStandard, true
+	}
+
+	return ColorModeNone, false
+}
+
+// detectTerminalColorMode determines the color mode from the writer and the
+// terminal environment variables, ignoring any forced mode.
+func detectTerminalColorMode(w io.Writer) ColorMode {
 	// Check if writer is a terminal file
 	f, ok := w.(*os.File)
 	if !ok {
@@ -113,6 +210,16 @@ func detectColorMode(w io.Writer) ColorMode {
 		return ColorModeNone
 	}
 
+	// CLICOLOR=0 asks for no colors even on a terminal
+	if os.Getenv("CLICOLOR") == "0" {
+		return ColorModeNone
+	}
+
+	// Platform-specific detection (e.g. enabling ANSI support on Windows)
+	if mode, ok := detectPlatformColorMode(f); ok {
+		return mode
+	}
+
 	// Check COLORTERM for truecolor support
 	// Many modern terminals set this: GNOME Terminal, iTerm2, VS Code, etc.
 	colorTerm := os.Getenv("COLORTERM")
@@ -150,6 +257,8 @@ func detectColorMode(w io.Writer) ColorMode {
 //	console.SetColorMode(rich.ColorModeTrueColor)  // Force 24-bit color
 //	console.SetColorMode(rich.ColorModeNone)       // Disable all colors
 func (c *Console) SetColorMode(mode ColorMode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.colorMode = mode
 }
 
@@ -162,9 +271,58 @@ func (c *Console) SetColorMode(mode ColorMode) {
 //		// Terminal supports full RGB colors
 //	}
 func (c *Console) ColorMode() ColorMode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.colorMode
 }
 
+// SetResetSequence sets the escape sequence written after each styled segment.
+// The default is DefaultResetSequence ("\x1b[0m"). Some terminals don't fully
+// restore default colors on SGR 0; for those, an explicit sequence such as
+// "\x1b[0;39;49m" can be used. An empty string restores the default.
+//
+// Example:
+//
+//	console.SetResetSequence("\x1b[0;39;49m")
+func (c *Console) SetResetSequence(seq string) {
+	c.resetSeq = seq
+}
+
+// ResetSequence returns the escape sequence written after each styled segment.
+func (c *Console) ResetSequence() string {
+	if c.resetSeq == "" {
+		return DefaultResetSequence
+	}
+	return c.resetSeq
+}
+
+// WithColorMode runs fn with the console temporarily set to the given color mode,
+// then restores the previous mode. The mode is restored even if fn panics.
+//
+// Use this to render a section in a specific mode (e.g. plain text that users
+// will copy and paste) without changing the console's mode for later output.
+//
+// The override applies to the whole console, not just to fn: while fn runs,
+// output written by other goroutines uses the temporary mode too. Don't call
+// it while other goroutines are writing to the console if their output must
+// keep the console's own mode.
+//
+// Example:
+//
+//	console.WithColorMode(rich.ColorModeNone, func() {
+//		console.Println("ssh-ed25519 AAAAC3Nza... user@host")
+//	})
+func (c *Console) WithColorMode(mode ColorMode, fn func()) {
+	c.mu.Lock()
+	previous := c.colorMode
+	c.colorMode = mode
+	c.mu.Unlock()
+
+	defer c.SetColorMode(previous)
+
+	fn()
+}
+
 // Width returns the console width in characters.
 // This is either the detected terminal width or the default of 80.
 //
@@ -174,6 +332,8 @@ func (c *Console) ColorMode() ColorMode {
 //
 //	maxWidth := console.Width()
 func (c *Console) Width() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.width
 }
 
@@ -184,9 +344,102 @@ func (c *Console) Width() int {
 //
 //	maxHeight := console.Height()
 func (c *Console) Height() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return c.height
 }
 
+// SetWidth overrides the console width used for layout.
+// This is useful for deterministic rendering in tests, or to render narrower
+// than the terminal. Values less than 1 are ignored.
+//
+// Example:
+//
+//	console.SetWidth(60)
+//	console.Renderln(tbl) // Laid out for 60 columns
+func (c *Console) SetWidth(width int) {
+	if width > 0 {
+		c.mu.Lock()
+		c.width = width
+		c.mu.Unlock()
+	}
+}
+
+// SetHeight overrides the console height used for layout.
+// Values less than 1 are ignored.
+//
+// Example:
+//
+//	console.SetHeight(40)
+func (c *Console) SetHeight(height int) {
+	if height > 0 {
+		c.mu.Lock()
+		c.height = height
+		c.mu.Unlock()
+	}
+}
+
+// RefreshSize re-queries the terminal dimensions, replacing the current width
+// and height (including any values set with SetWidth/SetHeight). Call this after
+// the terminal is resized, e.g. on SIGWINCH. If the size can't be determined,
+// the current dimensions are kept.
+//
+// Safe to call from another goroutine while the console is in use.
+//
+// Example:
+//
+//	sigs := make(chan os.Signal, 1)
+//	signal.Notify(sigs, syscall.SIGWINCH)
+//	go func() {
+//		for range sigs {
+//			console.RefreshSize()
+//		}
+//	}()
+func (c *Console) RefreshSize() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.width, c.height = detectSize(c.writer, c.width, c.height)
+}
+
+// SetRTL sets the default text direction for renderables.
+// When true, aligned content (table cells, panel content) is positioned from
+// the right: left-aligned content is padded on the left and vice versa.
+// Individual table columns can override this with Column.WithDirection.
+//
+// Example:
+//
+//	console.SetRTL(true) // Arabic/Hebrew content
+func (c *Console) SetRTL(rtl bool) {
+	c.rtl = rtl
+}
+
+// IsRTL reports whether the default text direction is right-to-left.
+func (c *Console) IsRTL() bool {
+	return c.rtl
+}
+
+// ExpandTabs sets the tab stop interval used to replace tabs with spaces.
+// When n is greater than zero, each tab in printed text (Print, Println,
+// Printf, and segments) and in string content being rendered (such as a
+// string in a panel) is replaced by spaces up to the next multiple of n
+// columns. This keeps layout predictable, since terminals expand tabs
+// inconsistently and width calculations can't account for them.
+//
+// Default is 0 (tabs are written unchanged).
+//
+// Example:
+//
+//	console.ExpandTabs(4)
+//	console.Render(panel.New(goSource)) // Indentation stays inside the border
+func (c *Console) ExpandTabs(n int) {
+	c.tabSize = max(n, 0)
+}
+
+// TabSize returns the tab stop interval set with ExpandTabs (0 = off).
+func (c *Console) TabSize() int {
+	return c.tabSize
+}
+
 // Print writes plain text to the console without styling.
 // Behaves like fmt.Print, writing the string representation of the arguments.
 // Returns the number of bytes written and any write error.
@@ -195,8 +448,7 @@ func (c *Console) Height() int {
 //
 //	console.Print("Hello", " ", "world")
 func (c *Console) Print(a ...interface{}) (n int, err error) {
-	s := fmt.Sprint(a...)
-	return c.writer.Write([]byte(s))
+	return c.write(fmt.Sprint(a...))
 }
 
 // Println writes plain text to the console followed by a newline.
@@ -207,8 +459,7 @@ func (c *Console) Print(a ...interface{}) (n int, err error) {
 //
 //	console.Println("Hello world")
 func (c *Console) Println(a ...interface{}) (n int, err error) {
-	s := fmt.Sprintln(a...)
-	return c.writer.Write([]byte(s))
+	return c.write(fmt.Sprintln(a...))
 }
 
 // Printf writes formatted text to the console.
@@ -219,8 +470,7 @@ func (c *Console) Println(a ...interface{}) (n int, err error) {
 //
 //	console.Printf("Count: %d\n", 42)
 func (c *Console) Printf(format string, a ...interface{}) (n int, err error) {
-	s := fmt.Sprintf(format, a...)
-	return c.writer.Write([]byte(s))
+	return c.write(fmt.Sprintf(format, a...))
 }
 
 // PrintStyled writes styled text to the console.
@@ -247,15 +497,23 @@ func (c *Console) PrintStyled(st StyledText) (n int, err error) {
 //	styled := style.Render("Success!")
 //	console.PrintStyledln(styled)
 func (c *Console) PrintStyledln(st StyledText) (n int, err error) {
-	// Write the styled text
-	n, err = c.PrintStyled(st)
-	if err != nil {
-		return n, err
-	}
+	return c.PrintSegmentsln(Segments{{Text: st.Text, Style: st.Style}})
+}
 
-	// Add newline
-	n2, err := c.writer.Write([]byte("\n"))
-	return n + n2, err
+// RenderNoNewline renders a Renderable to the console without a trailing newline.
+// It behaves exactly like Render; the explicit name makes it clear at the call
+// site that consecutive renders continue on the same line.
+//
+// Newlines between the lines of a multi-line renderable (such as a table) are
+// still written; only the final newline that Renderln appends is omitted.
+//
+// Example:
+//
+//	console.RenderNoNewline(status)
+//	console.RenderNoNewline(rich.NewRenderableString(" (cached)", rich.NewStyle().Dim()))
+//	console.Println()
+func (c *Console) RenderNoNewline(r Renderable) (n int, err error) {
+	return c.Render(r)
 }
 
 // PrintSegments writes segments to the console.
@@ -271,8 +529,49 @@ func (c *Console) PrintStyledln(st StyledText) (n int, err error) {
 //	}
 //	console.PrintSegments(segments)
 func (c *Console) PrintSegments(segments Segments) (n int, err error) {
-	s := segments.ToANSI(c.colorMode)
-	return c.writer.Write([]byte(s))
+	return c.writeSegments(segments, "")
+}
+
+// write writes s to the underlying writer in a single call while holding the
+// console's lock, so output from concurrent goroutines never interleaves.
+// Every method that prints goes through write or writeSegments (or holds the
+// lock itself).
+func (c *Console) write(s string) (n int, err error) {
+	return c.writeSegments(Segments{{Text: s}}, "")
+}
+
+// writeSegments formats segments for the console, appends suffix (unstyled),
+// and writes the result in a single call while holding the console's lock.
+// When recording, the segments are also kept for export.
+func (c *Console) writeSegments(segments Segments, suffix string) (n int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.writeSegmentsLocked(segments, suffix)
+}
+
+// writeSegmentsLocked is writeSegments for callers that hold c.mu.
+func (c *Console) writeSegmentsLocked(segments Segments, suffix string) (n int, err error) {
+	if c.tabSize > 0 {
+		segments = segments.ExpandTabs(c.tabSize)
+	}
+	if c.recording {
+		c.recorded = append(c.recorded, segments...)
+		if suffix != "" {
+			c.recorded = append(c.recorded, Segment{Text: suffix})
+		}
+	}
+	return c.writer.Write([]byte(c.formatSegmentsLocked(segments) + suffix))
+}
+
+// formatSegments converts segments to the exact text PrintSegments writes,
+// honoring the console's color mode.
+func (c *Console) formatSegments(segments Segments) string {
+	return segments.ToANSIWithReset(c.ColorMode(), c.ResetSequence())
+}
+
+// formatSegmentsLocked is formatSegments for callers that hold c.mu.
+func (c *Console) formatSegmentsLocked(segments Segments) string {
+	return segments.ToANSIWithReset(c.colorMode, c.ResetSequence())
 }
 
 // PrintSegmentsln writes segments to the console followed by a newline.
@@ -287,15 +586,43 @@ func (c *Console) PrintSegments(segments Segments) (n int, err error) {
 //	}
 //	console.PrintSegmentsln(segments)
 func (c *Console) PrintSegmentsln(segments Segments) (n int, err error) {
-	// Write the segments
-	n, err = c.PrintSegments(segments)
-	if err != nil {
-		return n, err
+	// One write for the line and its newline, so it can't be split by
+	// concurrent output
+	return c.writeSegments(segments, "\n")
+}
+
+// PrintLine writes segments as a full-width line with the given background,
+// followed by a newline. Segments without a background of their own take bg,
+// and the line is padded with bg-colored spaces to the console width (see
+// Segments.FillTo), which makes it suitable for highlighting a selected row.
+// Lines wider than the console are written as-is.
+// A nil bg prints the segments unchanged.
+//
+// Example:
+//
+//	for i, item := range items {
+//		line := rich.Segments{{Text: item}}
+//		if i == selected {
+//			console.PrintLine(line, rich.Blue)
+//		} else {
+//			console.PrintSegmentsln(line)
+//		}
+//	}
+func (c *Console) PrintLine(segments Segments, bg Color) (n int, err error) {
+	if bg == nil {
+		return c.PrintSegmentsln(segments)
+	}
+
+	line := make(Segments, 0, len(segments)+1)
+	for _, seg := range segments {
+		if seg.Style.BgColor() == nil {
+			seg.Style = seg.Style.Background(bg)
+		}
+		line = append(line, seg)
 	}
+	line = line.FillTo(c.Width(), NewStyle().Background(bg))
 
-	// Add newline
-	n2, err := c.writer.Write([]byte("\n"))
-	return n + n2, err
+	return c.PrintSegmentsln(line)
 }
 
 // Rule prints a horizontal rule across the console.
@@ -304,37 +631,94 @@ func (c *Console) PrintSegmentsln(segments Segments) (n int, err error) {
 //
 // Rules are useful for visually separating sections of output.
 // The line is rendered in dim style, and the title (if any) in bold.
+// Use RuleStyled to change the line character, styles, or title alignment.
 //
 // Example:
 //
 //	console.Rule("")           // Plain horizontal line
 //	console.Rule("Section 1")  // ─────── Section 1 ───────
 func (c *Console) Rule(title string) (n int, err error) {
+	return c.RuleStyled(title, DefaultRuleOptions())
+}
+
+// RuleOptions configures the appearance of a rule drawn by RuleStyled.
+// Start from DefaultRuleOptions and override the fields you need; note that
+// the zero value left-aligns the title and leaves everything unstyled.
+type RuleOptions struct {
+	Character  string // Line character(s), repeated to fill the width ("" = "─")
+	LineStyle  Style  // Style for the line
+	TitleStyle Style  // Style for the title
+	Align      Align  // Title position within the rule
+}
+
+// DefaultRuleOptions returns the options used by Rule: a dim "─" line with
+// a bold, centered title.
+func DefaultRuleOptions() RuleOptions {
+	return RuleOptions{
+		Character:  "─",
+		LineStyle:  NewStyle().Dim(),
+		TitleStyle: NewStyle().Bold(),
+		Align:      AlignCenter,
+	}
+}
+
+// RuleStyled prints a horizontal rule across the console using the given
+// options. The title, if any, is separated from the line by a space:
+//   - AlignLeft:   "Title ──────────────"
+//   - AlignCenter: "─────── Title ───────"
+//   - AlignRight:  "────────────── Title"
+//
+// Multi-character patterns (e.g. "=-") are repeated and cut to fit. If the
+// title is too long to leave room for the line, only the title is printed.
+//
+// Example:
+//
+//	opts := rich.DefaultRuleOptions()
+//	opts.Character = "═"
+//	opts.Align = rich.AlignLeft
+//	opts.TitleStyle = rich.NewStyle().Bold().Foreground(rich.Cyan)
+//	console.RuleStyled("Results", opts) // Results ═══════════════
+func (c *Console) RuleStyled(title string, opts RuleOptions) (n int, err error) {
+	char := opts.Character
+	if char == "" {
+		char = "─"
+	}
+	line := func(width int) Segments {
+		return (&Fill{Pattern: char, Style: opts.LineStyle}).Render(c, width)
+	}
+
+	width := c.Width()
 	var segments Segments
 
 	if title == "" {
 		// No title: just print a full-width line
-		line := strings.Repeat("─", c.width)
-		segments = Segments{{Text: line, Style: NewStyle().Dim()}}
+		segments = line(width)
 	} else {
-		// With title: center it with lines on both sides
-		titleLen := len(title)
+		// Measure in display columns so wide and multi-byte characters
+		// don't throw off the line lengths
+		titleLen := DisplayWidth(title)
 
 		// Check if title fits with padding (at least 2 chars on each side)
-		if titleLen+4 > c.width {
+		if titleLen+4 > width {
 			// Title too long, just print it without the rule
-			segments = Segments{{Text: title, Style: NewStyle().Bold()}}
+			segments = Segments{{Text: title, Style: opts.TitleStyle}}
 		} else {
-			// Calculate line lengths on each side
-			// Format: "─────── Title ───────"
-			// Title has 1 space on each side
-			leftLen := (c.width - titleLen - 2) / 2
-			rightLen := c.width - titleLen - 2 - leftLen
-
-			segments = Segments{
-				{Text: strings.Repeat("─", leftLen), Style: NewStyle().Dim()},
-				{Text: " " + title + " ", Style: NewStyle().Bold()},
-				{Text: strings.Repeat("─", rightLen), Style: NewStyle().Dim()},
+			switch opts.Align {
+			case AlignLeft:
+				// Format: "Title ──────────────"
+				segments = append(Segments{{Text: title + " ", Style: opts.TitleStyle}}, line(width-titleLen-1)...)
+			case AlignRight:
+				// Format: "────────────── Title"
+				segments = append(line(width-titleLen-1), Segment{Text: " " + title, Style: opts.TitleStyle})
+			default:
+				// Format: "─────── Title ───────"
+				// Title has 1 space on each side
+				leftLen := (width - titleLen - 2) / 2
+				rightLen := width - titleLen - 2 - leftLen
+
+				segments = append(segments, line(leftLen)...)
+				segments = append(segments, Segment{Text: " " + title + " ", Style: opts.TitleStyle})
+				segments = append(segments, line(rightLen)...)
 			}
 		}
 	}
@@ -342,8 +726,37 @@ func (c *Console) Rule(title string) (n int, err error) {
 	return c.PrintSegmentsln(segments)
 }
 
+// PrintAligned prints a line of text aligned within the console width,
+// followed by a newline. Padding is computed from the display width of the
+// text, so wide characters (CJK, emoji) are positioned correctly.
+// Text wider than the console is printed unchanged.
+//
+// Example:
+//
+//	console.PrintAligned("Report", rich.AlignCenter)
+//	console.PrintAligned(time.Now().Format(time.Kitchen), rich.AlignRight)
+func (c *Console) PrintAligned(text string, align Align) (n int, err error) {
+	left, right := alignPadding(DisplayWidth(text), c.Width(), align)
+	line := strings.Repeat(" ", left) + text + strings.Repeat(" ", right)
+	return c.write(line + "\n")
+}
+
+// PrintJustified prints a line of text stretched to the full console width,
+// followed by a newline. Spaces between words are widened evenly, with any
+// remainder going to the leftmost gaps. A single word, or text that doesn't
+// fit, is printed left-aligned.
+//
+// Example:
+//
+//	console.PrintJustified("Name Version Status") // "Name      Version      Status"
+func (c *Console) PrintJustified(text string) (n int, err error) {
+	return c.write(justify(text, c.Width()) + "\n")
+}
+
 // Writer returns the underlying io.Writer.
 // This provides direct access to the output destination for advanced use cases.
+// Writes made through it bypass the console's lock, so they may interleave
+// with concurrent console output.
 //
 // Example:
 //
@@ -364,6 +777,22 @@ func (c *Console) ANSIWriter() *ansi.Writer {
 	return ansi.NewWriter(c.writer)
 }
 
+// NewStrippingWriter returns a writer that removes ANSI escape sequences
+// from everything written to it before passing it on to w. Use it to send
+// styled output to a log file or other non-terminal sink as plain text.
+// Escape sequences split across writes are handled; call Flush when done.
+//
+// Example:
+//
+//	log := rich.NewStrippingWriter(logFile)
+//	console := rich.NewConsole(io.MultiWriter(os.Stdout, log))
+//	console.SetColorMode(rich.ColorModeTrueColor) // Not detected through MultiWriter
+//	console.PrintMarkupln("[bold green]Deployed[/]") // styled on screen, plain in the log
+//	log.Flush()
+func NewStrippingWriter(w io.Writer) *ansi.StrippingWriter {
+	return ansi.NewStrippingWriter(w)
+}
+
 // PrintMarkup writes markup text to the console.
 // Markup provides an easy way to add inline styling using tags.
 //
@@ -371,6 +800,7 @@ func (c *Console) ANSIWriter() *ansi.Writer {
 //   - Color names: red, blue, green, yellow, magenta, cyan, white, black, etc.
 //   - Hex colors: #FF0000, #00FF00
 //   - RGB colors: rgb(255,0,0)
+//   - 256-color palette: color(196)
 //   - Attributes: bold, italic, underline, strikethrough, dim, reverse
 //   - Background: "red on blue", "bold on white"
 //   - Combined: "bold red", "italic blue on yellow"
@@ -378,6 +808,10 @@ func (c *Console) ANSIWriter() *ansi.Writer {
 // Special characters:
 //   - [/] closes the current tag
 //   - [[ escapes to a literal [
+//   - [pad=N] inserts spaces so the following text starts at column N of
+//     the line (counting from 0 at the start of the markup, or of the line
+//     after a newline). It has no close tag, and does nothing if the text
+//     is already past column N.
 //
 // Examples:
 //
@@ -385,6 +819,7 @@ func (c *Console) ANSIWriter() *ansi.Writer {
 //	console.PrintMarkup("[red]Red[/] and [blue]blue[/]")
 //	console.PrintMarkup("[bold red on white]Styled[/]")
 //	console.PrintMarkup("[[This is not a tag]]") // Prints: [This is not a tag]
+//	console.PrintMarkupln("[dim]Status:[/][pad=12][green]running[/]")
 func (c *Console) PrintMarkup(m string) (n int, err error) {
 	return c.printMarkupInternal(m)
 }
@@ -396,20 +831,63 @@ func (c *Console) PrintMarkup(m string) (n int, err error) {
 //
 //	console.PrintMarkupln("[green]Success![/]")
 func (c *Console) PrintMarkupln(markup string) (n int, err error) {
-	// Write the markup
-	n, err = c.PrintMarkup(markup)
+	segments, err := parseMarkup(markup)
 	if err != nil {
-		return n, err
+		// On error, print raw markup without styling
+		return c.write(markup + "\n")
 	}
+	return c.PrintSegmentsln(segments)
+}
 
-	// Add newline
-	n2, err := c.writer.Write([]byte("\n"))
-	return n + n2, err
+// PrintMarkupStrict writes markup text to the console like PrintMarkup, but
+// checks that its tags are balanced first (see ParseMarkupStrict). Unbalanced
+// markup is not written; the error describing it is returned instead.
+//
+// PrintMarkup, by contrast, renders unbalanced markup as best it can: a tag
+// left open styles the rest of the text, and extra [/] tags are ignored.
+//
+// Example:
+//
+//	if _, err := console.PrintMarkupStrict(userMessage); err != nil {
+//		console.Error("Invalid markup:", err)
+//	}
+func (c *Console) PrintMarkupStrict(m string) (n int, err error) {
+	segments, err := ParseMarkupStrict(m)
+	if err != nil {
+		return 0, err
+	}
+	return c.PrintSegments(segments)
+}
+
+// PrintField writes a "key: value" status line followed by a newline.
+// The key is rendered dim so the value stands out, and the value is parsed
+// as markup (see PrintMarkup for syntax).
+// Returns the total number of bytes written and any write error.
+//
+// Example:
+//
+//	console.PrintField("status", "[green]OK[/]")   // status: OK
+//	console.PrintField("version", "[bold]1.2.0[/]")
+func (c *Console) PrintField(key string, valueMarkup string) (n int, err error) {
+	value, err := parseMarkup(valueMarkup)
+	if err != nil {
+		// On error, show the raw value without styling
+		value = Segments{{Text: valueMarkup, Style: NewStyle()}}
+	}
+
+	segments := Segments{
+		{Text: key, Style: NewStyle().Dim()},
+		{Text: ": ", Style: NewStyle()},
+	}
+	segments = append(segments, value...)
+
+	return c.PrintSegmentsln(segments)
 }
 
 // Render renders a Renderable to the console.
 // The renderable is converted to segments using the console's width,
-// then the segments are printed.
+// then the segments are printed. No trailing newline is added; any newlines
+// in the output come from the renderable itself (see Renderln and RenderNoNewline).
 //
 // This is the primary method for rendering complex widgets like tables and panels.
 //
@@ -421,10 +899,190 @@ func (c *Console) PrintMarkupln(markup string) (n int, err error) {
 //	panel := panel.New("Content").Title("Box")
 //	console.Render(panel)
 func (c *Console) Render(r Renderable) (n int, err error) {
-	segments := r.Render(c, c.width)
+	segments := r.Render(c, c.Width())
+	return c.PrintSegments(segments)
+}
+
+// RenderStreamable renders a StreamRenderable to the console, writing each
+// chunk as soon as it is emitted instead of building the whole output first.
+// Use this for very large renderables, such as tables with many thousands of
+// rows. Like Render, no trailing newline is added.
+//
+// Each chunk is written in a single call, but output from other goroutines
+// may land between chunks.
+//
+// After a write error, the remaining chunks are discarded and the error is
+// returned along with the number of bytes written before it.
+//
+// Example:
+//
+//	tbl := table.New().Headers("ID", "Value")
+//	for i := 0; i < 100000; i++ {
+//		tbl.Row(strconv.Itoa(i), values[i])
+//	}
+//	console.RenderStreamable(tbl)
+func (c *Console) RenderStreamable(r StreamRenderable) (n int, err error) {
+	// Chunks are rendered without the console's lock, so the renderable may
+	// use the console while streaming; the lock is only held to write each
+	// chunk
+	r.RenderStream(c, c.Width(), func(segments Segments) {
+		if err != nil {
+			return
+		}
+		var written int
+		written, err = c.writeSegments(segments, "")
+		n += written
+	})
+	return n, err
+}
+
+// RenderANSI renders a Renderable and returns the ANSI-escaped output as a string
+// instead of writing it. Unlike normal output, styling is always included: the
+// console's color mode is used if one is active (auto-detected or set via
+// SetColorMode), and ColorModeStandard is used if the console has colors disabled.
+//
+// Use this to capture styled output (e.g. to a file that will be cat'd later)
+// from a console whose writer is not a terminal.
+//
+// Example:
+//
+//	console.SetColorMode(rich.ColorModeTrueColor)
+//	os.WriteFile("report.ans", []byte(console.RenderANSI(tbl)), 0o644)
+func (c *Console) RenderANSI(r Renderable) string {
+	mode := c.ColorMode()
+	if mode == ColorModeNone {
+		mode = ColorModeStandard
+	}
+	return r.Render(c, c.Width()).ToANSIWithReset(mode, c.ResetSequence())
+}
+
+// RenderToString renders a Renderable and returns the output as a string
+// instead of writing it. The result is byte-for-byte what Render would have
+// written: it uses the console's width and color mode, so it contains ANSI
+// codes only when colors are enabled.
+//
+// Example:
+//
+//	s := console.RenderToString(tbl)
+//	log.Print(s)
+func (c *Console) RenderToString(r Renderable) string {
+	segments := r.Render(c, c.Width())
+	return c.formatSegments(segments)
+}
+
+// ExportText renders a Renderable and returns it as plain text, with all ANSI
+// escape sequences removed. Unlike switching the console to ColorModeNone, the
+// console keeps its color mode, so one console can draw to the terminal and
+// also produce a clean copy for a log file. Escape codes embedded in the
+// renderable's own text are stripped too.
+//
+// Example:
+//
+//	console.Render(tbl)                                 // colored, on screen
+//	logFile.WriteString(console.ExportText(tbl) + "\n") // plain, in the log
+func (c *Console) ExportText(r Renderable) string {
+	return ansi.StripANSI(c.RenderToString(r))
+}
+
+// Capture is an alias for RenderToString.
+// It reads naturally in tests that assert on rendered output.
+//
+// Example:
+//
+//	if got := console.Capture(tbl); !strings.Contains(got, "Alice") {
+//		t.Errorf("missing row: %q", got)
+//	}
+func (c *Console) Capture(r Renderable) string {
+	return c.RenderToString(r)
+}
+
+// RenderCentered renders a Renderable centered within the console's width and height.
+// The renderable is rendered at its natural width (using Measurable when available,
+// otherwise the width of its longest line), then padded with spaces on the left and
+// right and with blank lines above and below so the block sits in the middle of the
+// console. Content taller than the console is not padded vertically.
+//
+// Useful for splash screens and banners.
+//
+// Example:
+//
+//	console.RenderCentered(panel.New("Welcome!").Expand(false))
+func (c *Console) RenderCentered(r Renderable) (n int, err error) {
+	consoleWidth, consoleHeight := c.Width(), c.Height()
+
+	// Determine the renderable's natural width
+	width := consoleWidth
+	if m, ok := r.(Measurable); ok {
+		width = m.Measure(c, consoleWidth).Get(consoleWidth)
+	} else {
+		width = maxLineWidth(r.Render(c, consoleWidth).SplitLines())
+	}
+	if width > consoleWidth {
+		width = consoleWidth
+	}
+
+	lines := r.Render(c, width).SplitLines()
+
+	// Block width is the widest rendered line
+	blockWidth := maxLineWidth(lines)
+	leftPad := (consoleWidth - blockWidth) / 2
+	if leftPad < 0 {
+		leftPad = 0
+	}
+
+	topPad := 0
+	bottomPad := 0
+	if len(lines) < consoleHeight {
+		topPad = (consoleHeight - len(lines)) / 2
+		bottomPad = consoleHeight - len(lines) - topPad
+	}
+
+	var segments Segments
+	blank := strings.Repeat(" ", consoleWidth)
+
+	// Top padding
+	for i := 0; i < topPad; i++ {
+		segments = append(segments, Segment{Text: blank + "\n"})
+	}
+
+	// Content lines, padded to the full console width
+	for i, line := range lines {
+		rightPad := consoleWidth - leftPad - line.Width()
+		if rightPad < 0 {
+			rightPad = 0
+		}
+
+		segments = append(segments, Segment{Text: strings.Repeat(" ", leftPad)})
+		segments = append(segments, line...)
+		segments = append(segments, Segment{Text: strings.Repeat(" ", rightPad)})
+
+		if i < len(lines)-1 || bottomPad > 0 {
+			segments = append(segments, Segment{Text: "\n"})
+		}
+	}
+
+	// Bottom padding (no newline after the final line, like Render)
+	for i := 0; i < bottomPad; i++ {
+		segments = append(segments, Segment{Text: blank})
+		if i < bottomPad-1 {
+			segments = append(segments, Segment{Text: "\n"})
+		}
+	}
+
 	return c.PrintSegments(segments)
 }
 
+// maxLineWidth returns the display width of the widest line.
+func maxLineWidth(lines []Segments) int {
+	maxWidth := 0
+	for _, line := range lines {
+		if w := line.Width(); w > maxWidth {
+			maxWidth = w
+		}
+	}
+	return maxWidth
+}
+
 // Renderln renders a Renderable to the console followed by a newline.
 // This is the same as Render but adds a trailing newline for convenience.
 //
@@ -433,13 +1091,5 @@ func (c *Console) Render(r Renderable) (n int, err error) {
 //	table := table.New().Headers("Name", "Age").Row("Alice", "30")
 //	console.Renderln(table)
 func (c *Console) Renderln(r Renderable) (n int, err error) {
-	// Render the content
-	n, err = c.Render(r)
-	if err != nil {
-		return n, err
-	}
-
-	// Add newline
-	n2, err := c.writer.Write([]byte("\n"))
-	return n + n2, err
+	return c.PrintSegmentsln(r.Render(c, c.Width()))
 }
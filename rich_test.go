@@ -2,8 +2,12 @@ package rich
 
 import (
 	"bytes"
+	"fmt"
+	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestConsoleBasicOutput(t *testing.T) {
@@ -99,6 +103,78 @@ func TestConsoleRule(t *testing.T) {
 	}
 }
 
+func TestConsoleRuleStyled(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.SetWidth(20)
+
+	tests := []struct {
+		title string
+		char  string
+		align Align
+		want  string
+	}{
+		{"Results", "═", AlignLeft, "Results ════════════\n"},
+		{"Results", "•", AlignRight, "•••••••••••• Results\n"},
+		{"Mid", "=-", AlignCenter, "=-=-=-= Mid =-=-=-=-\n"},
+		{"", "*", AlignCenter, "********************\n"},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+		opts := DefaultRuleOptions()
+		opts.Character = tt.char
+		opts.Align = tt.align
+		console.RuleStyled(tt.title, opts)
+
+		if got := buf.String(); got != tt.want {
+			t.Errorf("RuleStyled(%q, %q, %d) = %q, want %q", tt.title, tt.char, tt.align, got, tt.want)
+		}
+	}
+
+	// Custom styles are applied to the line and title
+	console.SetColorMode(ColorModeStandard)
+	buf.Reset()
+	opts := DefaultRuleOptions()
+	opts.LineStyle = NewStyle().Foreground(Red)
+	opts.TitleStyle = NewStyle().Foreground(Blue)
+	console.RuleStyled("T", opts)
+
+	got := buf.String()
+	if !strings.Contains(got, "\x1b[31m─") || !strings.Contains(got, "\x1b[34m T ") {
+		t.Errorf("Expected styled line and title, got %q", got)
+	}
+}
+
+func TestConsoleRuleDisplayWidth(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.SetWidth(30)
+
+	for _, title := range []string{"Rule ☰ Section", "日本語", "🚀 Launch"} {
+		for _, align := range []Align{AlignLeft, AlignCenter, AlignRight} {
+			buf.Reset()
+			opts := DefaultRuleOptions()
+			opts.Align = align
+			console.RuleStyled(title, opts)
+
+			line := strings.TrimSuffix(buf.String(), "\n")
+			if w := DisplayWidth(line); w != 30 {
+				t.Errorf("Rule %q (align %d) is %d columns wide, want 30: %q", title, align, w, line)
+			}
+		}
+	}
+
+	// Centered titles have balanced sides
+	buf.Reset()
+	console.Rule("日本")
+	if got := buf.String(); got != strings.Repeat("─", 12)+" 日本 "+strings.Repeat("─", 12)+"\n" {
+		t.Errorf("Expected balanced rule, got %q", got)
+	}
+}
+
 func TestConsoleRuleEmpty(t *testing.T) {
 	var buf bytes.Buffer
 	console := NewConsole(&buf)
@@ -139,3 +215,558 @@ func TestConsoleHeight(t *testing.T) {
 		t.Errorf("Height should be positive, got %d", height)
 	}
 }
+
+func TestConsolePrintField(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+
+	console.PrintField("status", "[green]OK[/]")
+
+	got := buf.String()
+	want := "\x1b[2mstatus\x1b[0m: \x1b[32mOK\x1b[0m\n"
+
+	if got != want {
+		t.Errorf("Output = %q, want %q", got, want)
+	}
+}
+
+func TestConsoleSizeFromEnv(t *testing.T) {
+	t.Setenv("COLUMNS", "120")
+	t.Setenv("LINES", "40")
+
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+
+	if console.Width() != 120 {
+		t.Errorf("Width = %d, want 120", console.Width())
+	}
+	if console.Height() != 40 {
+		t.Errorf("Height = %d, want 40", console.Height())
+	}
+}
+
+func TestConsoleSizeFromEnvInvalid(t *testing.T) {
+	t.Setenv("COLUMNS", "wide")
+	t.Setenv("LINES", "-3")
+
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+
+	if console.Width() != 80 {
+		t.Errorf("Width = %d, want default 80", console.Width())
+	}
+	if console.Height() != 24 {
+		t.Errorf("Height = %d, want default 24", console.Height())
+	}
+}
+
+func TestConsoleRenderCentered(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.width = 10
+	console.height = 5
+
+	console.RenderCentered(Lines{
+		NewRenderableString("ab", NewStyle()),
+		NewRenderableString("cdef", NewStyle()),
+	})
+
+	got := buf.String()
+	want := "          \n" +
+		"   ab     \n" +
+		"   cdef   \n" +
+		"          \n" +
+		"          "
+
+	if got != want {
+		t.Errorf("Output = %q, want %q", got, want)
+	}
+
+	// Wide characters are centered by display width, not rune count
+	buf.Reset()
+	console.height = 1
+	console.RenderCentered(NewRenderableString("日本", NewStyle()))
+	if got, want := buf.String(), "   日本   "; got != want {
+		t.Errorf("Wide output = %q, want %q", got, want)
+	}
+}
+
+func TestConsoleRenderANSI(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeTrueColor)
+
+	r := NewRenderableString("Hi", NewStyle().Foreground(RGB(255, 100, 50)))
+	got := console.RenderANSI(r)
+
+	if !strings.Contains(got, "\x1b[38;2;255;100;50m") {
+		t.Errorf("Expected truecolor escape in %q", got)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("RenderANSI should not write to the console, wrote %q", buf.String())
+	}
+
+	// Colors are still emitted when the console has them disabled
+	console.SetColorMode(ColorModeNone)
+	if got := console.RenderANSI(r); !strings.Contains(got, "\x1b[") {
+		t.Errorf("Expected ANSI escapes with colors disabled, got %q", got)
+	}
+}
+
+func TestConsoleRenderToString(t *testing.T) {
+	r := Lines{
+		NewRenderableString("Bold", NewStyle().Bold()),
+		NewRenderableString("Red", NewStyle().Foreground(Red)),
+	}
+
+	for _, mode := range []ColorMode{ColorModeNone, ColorModeStandard, ColorModeTrueColor} {
+		var buf bytes.Buffer
+		console := NewConsole(&buf)
+		console.SetColorMode(mode)
+
+		got := console.RenderToString(r)
+		if buf.Len() != 0 {
+			t.Errorf("RenderToString should not write to the console, wrote %q", buf.String())
+		}
+
+		console.Render(r)
+		if got != buf.String() {
+			t.Errorf("Mode %v: RenderToString = %q, Render wrote %q", mode, got, buf.String())
+		}
+
+		if captured := console.Capture(r); captured != got {
+			t.Errorf("Mode %v: Capture = %q, want %q", mode, captured, got)
+		}
+	}
+}
+
+func TestConsoleRenderNoNewline(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	r := NewRenderableString("abc", NewStyle())
+
+	console.RenderNoNewline(r)
+	console.RenderNoNewline(r)
+	if got := buf.String(); got != "abcabc" {
+		t.Errorf("RenderNoNewline output = %q, want %q", got, "abcabc")
+	}
+
+	buf.Reset()
+	console.Renderln(r)
+	if got := buf.String(); got != "abc\n" {
+		t.Errorf("Renderln output = %q, want %q", got, "abc\n")
+	}
+}
+
+// chunkedRenderable emits each of its lines as a separate chunk.
+type chunkedRenderable []string
+
+func (c chunkedRenderable) RenderStream(console *Console, width int, emit func(Segments)) {
+	for _, line := range c {
+		emit(Segments{{Text: line + "\n"}})
+	}
+}
+
+func TestConsoleRenderStreamable(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	n, err := console.RenderStreamable(chunkedRenderable{"one", "two", "three"})
+	if err != nil {
+		t.Fatalf("RenderStreamable returned error: %v", err)
+	}
+
+	want := "one\ntwo\nthree\n"
+	if got := buf.String(); got != want {
+		t.Errorf("RenderStreamable output = %q, want %q", got, want)
+	}
+	if n != len(want) {
+		t.Errorf("RenderStreamable wrote %d bytes, want %d", n, len(want))
+	}
+}
+
+// widthRenderable streams lines that report the console width, using the
+// console from inside RenderStream.
+type widthRenderable int
+
+func (w widthRenderable) RenderStream(console *Console, width int, emit func(Segments)) {
+	for i := 0; i < int(w); i++ {
+		emit(Segments{{Text: fmt.Sprintf("%d\n", console.Width())}})
+	}
+}
+
+func TestConsoleRenderStreamableUsesConsole(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.SetWidth(30)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		console.RenderStreamable(widthRenderable(2))
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("RenderStreamable deadlocked when the renderable used the console")
+	}
+	if got := buf.String(); got != "30\n30\n" {
+		t.Errorf("RenderStreamable output = %q, want %q", got, "30\n30\n")
+	}
+}
+
+func TestConsolePrintAligned(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.width = 10
+
+	tests := []struct {
+		text  string
+		align Align
+		want  string
+	}{
+		{"abc", AlignLeft, "abc       \n"},
+		{"abc", AlignRight, "       abc\n"},
+		{"abc", AlignCenter, "   abc    \n"},
+		{"日本", AlignRight, "      日本\n"}, // Two wide characters fill four columns
+		{"toolongtext", AlignCenter, "toolongtext\n"},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+		console.PrintAligned(tt.text, tt.align)
+		if got := buf.String(); got != tt.want {
+			t.Errorf("PrintAligned(%q, %v) = %q, want %q", tt.text, tt.align, got, tt.want)
+		}
+	}
+}
+
+func TestConsolePrintJustified(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	console.width = 12
+
+	console.PrintJustified("a b c")
+	if got, want := buf.String(), "a     b    c\n"; got != want {
+		t.Errorf("PrintJustified = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	console.PrintJustified("single")
+	if got, want := buf.String(), "single      \n"; got != want {
+		t.Errorf("PrintJustified = %q, want %q", got, want)
+	}
+}
+
+func TestConsoleWithColorMode(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+
+	console.WithColorMode(ColorModeNone, func() {
+		console.PrintMarkup("[red]plain[/]")
+	})
+	if got := buf.String(); got != "plain" {
+		t.Errorf("Output inside callback = %q, want %q", got, "plain")
+	}
+
+	buf.Reset()
+	console.PrintMarkup("[red]colored[/]")
+	if got := buf.String(); !strings.Contains(got, "\x1b[31m") {
+		t.Errorf("Expected colored output after callback, got %q", got)
+	}
+
+	// The previous mode is restored even if the callback panics
+	func() {
+		defer func() { recover() }()
+		console.WithColorMode(ColorModeNone, func() {
+			panic("boom")
+		})
+	}()
+	if console.ColorMode() != ColorModeStandard {
+		t.Errorf("Expected ColorModeStandard after panic, got %v", console.ColorMode())
+	}
+}
+
+func TestConsoleWithColorModeConcurrent(t *testing.T) {
+	w := &chunkWriter{}
+	console := NewConsole(w)
+	console.SetColorMode(ColorModeStandard)
+
+	// Switching modes while another goroutine writes must not race;
+	// run with -race to check
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			console.PrintMarkupln("[red]line[/]")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		console.WithColorMode(ColorModeNone, func() {})
+	}
+	<-done
+
+	if console.ColorMode() != ColorModeStandard {
+		t.Errorf("Expected ColorModeStandard after overrides, got %v", console.ColorMode())
+	}
+}
+
+func TestDetectColorModeForced(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want ColorMode
+	}{
+		{"no overrides", map[string]string{}, ColorModeNone},
+		{"FORCE_COLOR=1", map[string]string{"FORCE_COLOR": "1"}, ColorModeStandard},
+		{"FORCE_COLOR empty", map[string]string{"FORCE_COLOR": ""}, ColorModeStandard},
+		{"FORCE_COLOR=true", map[string]string{"FORCE_COLOR": "true"}, ColorModeStandard},
+		{"FORCE_COLOR=2", map[string]string{"FORCE_COLOR": "2"}, ColorMode256},
+		{"FORCE_COLOR=3", map[string]string{"FORCE_COLOR": "3"}, ColorModeTrueColor},
+		{"FORCE_COLOR=0", map[string]string{"FORCE_COLOR": "0", "CLICOLOR_FORCE": "1"}, ColorModeNone},
+		{"CLICOLOR_FORCE=1", map[string]string{"CLICOLOR_FORCE": "1"}, ColorModeStandard},
+		{"CLICOLOR_FORCE=0", map[string]string{"CLICOLOR_FORCE": "0"}, ColorModeNone},
+		{"NO_COLOR wins", map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "3"}, ColorModeNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"NO_COLOR", "FORCE_COLOR", "CLICOLOR_FORCE", "CLICOLOR"} {
+				t.Setenv(name, "")
+				os.Unsetenv(name)
+			}
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			// A bytes.Buffer is never a terminal
+			var buf bytes.Buffer
+			if got := detectColorMode(&buf); got != tt.want {
+				t.Errorf("detectColorMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConsoleSetWidth(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	console.SetWidth(12)
+	console.SetHeight(5)
+	if console.Width() != 12 || console.Height() != 5 {
+		t.Errorf("Size = %dx%d, want 12x5", console.Width(), console.Height())
+	}
+
+	// Layout follows the new width
+	console.Render(FillPattern("-", NewStyle()))
+	if got := buf.String(); got != "------------" {
+		t.Errorf("Render output = %q, want 12 dashes", got)
+	}
+
+	// Invalid values are ignored
+	console.SetWidth(0)
+	console.SetHeight(-1)
+	if console.Width() != 12 || console.Height() != 5 {
+		t.Errorf("Size = %dx%d, want 12x5 after invalid overrides", console.Width(), console.Height())
+	}
+}
+
+func TestConsoleRefreshSize(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetWidth(50)
+
+	t.Setenv("COLUMNS", "100")
+	t.Setenv("LINES", "30")
+	console.RefreshSize()
+
+	if console.Width() != 100 || console.Height() != 30 {
+		t.Errorf("Size = %dx%d, want 100x30", console.Width(), console.Height())
+	}
+}
+
+func TestConsoleRefreshSizeConcurrent(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+	t.Setenv("COLUMNS", "40")
+
+	// Resizing from another goroutine (as on SIGWINCH) must not race with
+	// rendering; run with -race to check
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			console.RefreshSize()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		console.Rule("Title")
+	}
+	<-done
+
+	if console.Width() != 40 {
+		t.Errorf("Width = %d, want 40", console.Width())
+	}
+}
+
+func TestConsoleSetResetSequence(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+	console.SetResetSequence("\x1b[0;39;49m")
+
+	console.PrintMarkup("[red]a[/]b")
+
+	want := "\x1b[31ma\x1b[0;39;49mb"
+	if got := buf.String(); got != want {
+		t.Errorf("Output = %q, want %q", got, want)
+	}
+
+	// An empty sequence restores the default
+	console.SetResetSequence("")
+	if console.ResetSequence() != DefaultResetSequence {
+		t.Errorf("ResetSequence() = %q, want default", console.ResetSequence())
+	}
+}
+
+func TestConsolePrintLine(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+	console.SetWidth(10)
+
+	console.PrintLine(Segments{{Text: "item", Style: NewStyle().Bold()}}, Blue)
+
+	bgCode := NewStyle().Background(Blue).toANSI(ColorModeStandard)
+	want := NewStyle().Bold().Background(Blue).toANSI(ColorModeStandard) + "item" + DefaultResetSequence +
+		bgCode + "      " + DefaultResetSequence + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintLine() = %q, want %q", got, want)
+	}
+
+	// Without a background the segments print as-is
+	buf.Reset()
+	console.SetColorMode(ColorModeNone)
+	console.PrintLine(Segments{{Text: "item"}}, nil)
+	if got := buf.String(); got != "item\n" {
+		t.Errorf("PrintLine(nil) = %q, want %q", got, "item\n")
+	}
+}
+
+// chunkWriter appends each Write in small pieces, widening the window in
+// which unsynchronized writers would interleave. It relies on the console
+// for synchronization.
+type chunkWriter struct {
+	buf strings.Builder
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	for i := 0; i < len(p); i += 3 {
+		end := min(i+3, len(p))
+		w.buf.Write(p[i:end])
+	}
+	return len(p), nil
+}
+
+func TestConsoleConcurrentWrites(t *testing.T) {
+	w := &chunkWriter{}
+	console := NewConsole(w)
+	console.SetColorMode(ColorModeNone)
+
+	const workers, lines = 16, 50
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for j := 0; j < lines; j++ {
+				switch j % 3 {
+				case 0:
+					console.Println("worker", worker, "line", j)
+				case 1:
+					console.PrintMarkupln(fmt.Sprintf("[bold]worker %d line %d[/]", worker, j))
+				default:
+					console.Renderln(NewRenderableString(fmt.Sprintf("worker %d line %d", worker, j), NewStyle()))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	out := strings.TrimSuffix(w.buf.String(), "\n")
+	got := strings.Split(out, "\n")
+	if len(got) != workers*lines {
+		t.Fatalf("Expected %d lines, got %d", workers*lines, len(got))
+	}
+
+	seen := make(map[string]bool)
+	for _, line := range got {
+		var worker, n int
+		if _, err := fmt.Sscanf(line, "worker %d line %d", &worker, &n); err != nil || line != fmt.Sprintf("worker %d line %d", worker, n) {
+			t.Fatalf("Found a split or garbled line: %q", line)
+		}
+		seen[line] = true
+	}
+	if len(seen) != workers*lines {
+		t.Errorf("Expected %d distinct lines, got %d", workers*lines, len(seen))
+	}
+}
+
+func TestConsoleExpandTabs(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeNone)
+
+	// Off by default
+	console.Print("a\tb")
+	if got := buf.String(); got != "a\tb" {
+		t.Errorf("Print() = %q, want tabs unchanged by default", got)
+	}
+
+	console.ExpandTabs(4)
+	if console.TabSize() != 4 {
+		t.Errorf("TabSize() = %d, want 4", console.TabSize())
+	}
+
+	buf.Reset()
+	console.Println("x\ty")
+	console.Printf("%s\t%s\n", "abcd", "e")
+	console.Renderln(NewRenderableString("ab\tc", NewStyle()))
+	want := "x   y\nabcd    e\nab  c\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Output = %q, want %q", got, want)
+	}
+
+	// Measurement sees the expanded text
+	m := NewRenderableString("\tab", NewStyle()).Measure(console, 80)
+	if m.Maximum != 6 {
+		t.Errorf("Measure().Maximum = %d, want 6", m.Maximum)
+	}
+}
+
+func TestNewStrippingWriter(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewStrippingWriter(&buf)
+	console := NewConsole(log)
+	console.SetColorMode(ColorModeTrueColor)
+
+	console.PrintMarkupln("[bold #ff8800]Deployed[/] to [u]prod[/]")
+	log.Flush()
+
+	if got := buf.String(); got != "Deployed to prod\n" {
+		t.Errorf("Expected plain text, got %q", got)
+	}
+}
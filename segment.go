@@ -2,6 +2,7 @@ package rich
 
 import (
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -55,6 +56,9 @@ func (s Segments) String() string {
 //		{Text: " 世界", Style: NewStyle()}, // Chinese for "world"
 //	}
 //	length := segments.Length() // Returns: 8 (5 + 1 + 2)
+//
+// Length is not the same as display width: wide characters count as one
+// here but occupy two terminal columns. See Width.
 func (s Segments) Length() int {
 	length := 0
 	for _, seg := range s {
@@ -64,15 +68,40 @@ func (s Segments) Length() int {
 	return length
 }
 
+// Width returns the number of terminal columns needed to display the segments.
+// Unlike Length, which counts runes, Width accounts for wide characters
+// (CJK, emoji) that occupy two cells and zero-width marks that occupy none.
+// Use Width when padding or aligning rendered output.
+//
+// Example:
+//
+//	segments := Segments{{Text: "Hi 世界"}}
+//	segments.Length() // 5
+//	segments.Width()  // 7
+func (s Segments) Width() int {
+	width := 0
+	for _, seg := range s {
+		width += DisplayWidth(seg.Text)
+	}
+	return width
+}
+
+// DefaultResetSequence is the escape sequence written after each styled
+// segment to clear its formatting (SGR 0: reset all attributes).
+const DefaultResetSequence = "\x1b[0m"
+
 // ToANSI converts segments to an ANSI-escaped string.
 // Each segment's style is converted to ANSI escape sequences appropriate
-// for the given color mode, with a reset sequence (ESC[0m) after each segment.
+// for the given color mode, with a reset sequence (ESC[0m) after each
+// styled run.
 //
 // If mode is ColorModeNone, returns plain text with no escape sequences
 // (equivalent to calling String()).
 //
-// The reset sequence ensures that each segment's style doesn't bleed into
-// subsequent segments, maintaining style isolation.
+// Adjacent segments that produce the same escape sequence are written as a
+// single run: the style is emitted once and reset only when the next
+// segment's style differs (or at the end). The reset ensures that a style
+// doesn't bleed into subsequent segments, maintaining style isolation.
 //
 // Example:
 //
@@ -83,32 +112,200 @@ func (s Segments) Length() int {
 //	ansi := segments.ToANSI(ColorModeTrueColor)
 //	// Returns: "\x1b[1m\x1b[38;2;255;0;0mError: \x1b[0mFile not found"
 func (s Segments) ToANSI(mode ColorMode) string {
+	return s.ToANSIWithReset(mode, DefaultResetSequence)
+}
+
+// ToANSIWithReset is like ToANSI but writes the given reset sequence after each
+// styled segment instead of DefaultResetSequence. Use this for terminals that
+// need a more explicit reset (see Console.SetResetSequence).
+//
+// Example:
+//
+//	ansi := segments.ToANSIWithReset(rich.ColorModeStandard, "\x1b[0;39;49m")
+func (s Segments) ToANSIWithReset(mode ColorMode, reset string) string {
 	// No styling in ColorModeNone, just return plain text
 	if mode == ColorModeNone {
 		return s.String()
 	}
 
 	var b strings.Builder
+	open := "" // Escape sequence of the style currently in effect
 	for _, seg := range s {
+		if seg.Text == "" {
+			continue
+		}
+
 		// Get the ANSI sequence for this segment's style
 		ansi := seg.Style.toANSI(mode)
 
-		// Apply the style if it has any formatting
-		if ansi != "" {
+		// Switch styles only when this segment looks different from the last
+		if ansi != open {
+			if open != "" {
+				b.WriteString(reset)
+			}
 			b.WriteString(ansi)
+			open = ansi
 		}
 
 		// Write the text content
 		b.WriteString(seg.Text)
+	}
 
-		// Reset formatting after this segment if we applied any
-		if ansi != "" {
-			b.WriteString("\x1b[0m") // SGR 0: Reset all attributes
-		}
+	// Reset formatting after the final styled run
+	if open != "" {
+		b.WriteString(reset)
 	}
 	return b.String()
 }
 
+// Highlight returns a copy of the segments with every occurrence of query
+// styled with the highlight style layered over the segment's own style
+// (see Style.Combine). Matching segments are split into sub-segments so
+// only the matched text carries the highlight.
+//
+// Matching is case-sensitive and happens within each segment; occurrences
+// spanning a segment boundary are not highlighted. An empty query returns
+// the segments unchanged. Use HighlightFold for case-insensitive matching.
+//
+// Example:
+//
+//	segments := Segments{{Text: "disk error: retrying", Style: NewStyle()}}
+//	highlighted := segments.Highlight("error", NewStyle().Bold().Foreground(Red))
+//	// Result: {"disk ", plain}, {"error", bold red}, {": retrying", plain}
+func (s Segments) Highlight(query string, style Style) Segments {
+	return s.highlight(query, style, false)
+}
+
+// HighlightFold is like Highlight but matches query case-insensitively,
+// using Unicode simple case folding, so a match may differ in byte length
+// from the query (e.g. "ſ" matches "s" and the Kelvin sign matches "k").
+//
+// Example:
+//
+//	segments.HighlightFold("error", NewStyle().Reverse()) // Matches "Error", "ERROR", ...
+func (s Segments) HighlightFold(query string, style Style) Segments {
+	return s.highlight(query, style, true)
+}
+
+// highlight implements Highlight and HighlightFold.
+// Each segment's text is scanned rune by rune; matched runs are emitted with
+// the combined style and the text between matches keeps the original style.
+func (s Segments) highlight(query string, style Style, fold bool) Segments {
+	if query == "" {
+		return append(Segments(nil), s...)
+	}
+
+	result := make(Segments, 0, len(s))
+	for _, seg := range s {
+		text := seg.Text
+		start := 0 // Start of the pending unhighlighted text
+
+		for i := 0; i < len(text); {
+			if n := matchLen(text[i:], query, fold); n > 0 {
+				// Emit the text before the match, then the match itself
+				if i > start {
+					result = append(result, Segment{Text: text[start:i], Style: seg.Style})
+				}
+				result = append(result, Segment{Text: text[i : i+n], Style: seg.Style.Combine(style)})
+				i += n
+				start = i
+				continue
+			}
+
+			// Advance by one rune so multi-byte characters are never split
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+		}
+
+		// Emit any remaining text after the last match
+		if start < len(text) {
+			result = append(result, Segment{Text: text[start:], Style: seg.Style})
+		}
+	}
+
+	return result
+}
+
+// matchLen returns the length in bytes of the prefix of text that matches
+// query, or 0 if text doesn't start with it. With fold, runes are compared
+// under Unicode simple case folding, so the match is measured in text's
+// bytes rather than query's.
+func matchLen(text, query string, fold bool) int {
+	if !fold {
+		if strings.HasPrefix(text, query) {
+			return len(query)
+		}
+		return 0
+	}
+
+	n := 0
+	for _, qr := range query {
+		if n >= len(text) {
+			return 0
+		}
+		tr, size := utf8.DecodeRuneInString(text[n:])
+		if !equalFoldRune(tr, qr) {
+			return 0
+		}
+		n += size
+	}
+	return n
+}
+
+// equalFoldRune reports whether a and b are equal under simple case
+// folding, walking a's fold orbit the same way strings.EqualFold does.
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitLines splits the segments into lines at newline characters.
+// Segments containing newlines are split into parts that keep their style,
+// and the newlines themselves are removed. Empty lines are preserved as
+// empty Segments, so the result always has one more line than there are
+// newlines. An empty input returns no lines.
+//
+// This is the building block for renderables that lay out other renderables
+// line by line (padding, centering, side-by-side columns, etc.).
+//
+// Example:
+//
+//	segments := Segments{{Text: "Hello\nWorld", Style: NewStyle().Bold()}}
+//	lines := segments.SplitLines()
+//	// lines[0] == Segments{{Text: "Hello", ...}}, lines[1] == Segments{{Text: "World", ...}}
+func (s Segments) SplitLines() []Segments {
+	if len(s) == 0 {
+		return nil
+	}
+
+	var lines []Segments
+	var current Segments
+
+	for _, seg := range s {
+		parts := strings.Split(seg.Text, "\n")
+		for i, part := range parts {
+			if i > 0 {
+				// Newline: finish the current line and start a new one
+				lines = append(lines, current)
+				current = nil
+			}
+			if part != "" {
+				current = append(current, Segment{Text: part, Style: seg.Style})
+			}
+		}
+	}
+
+	// The last line is always kept, even if empty
+	return append(lines, current)
+}
+
 // Append adds segments to the end of this segment slice.
 // Returns a new Segments slice with the additional segments appended.
 //
@@ -120,6 +317,110 @@ func (s Segments) Append(segs ...Segment) Segments {
 	return append(s, segs...)
 }
 
+// FillTo pads the segments with spaces in the given style until they are
+// width display columns wide. Use a style with a background color to extend
+// a highlight to the edge of the line, so a selected row is a solid bar
+// rather than stopping where the text ends. Segments that are already at
+// least width wide are returned unchanged.
+//
+// FillTo measures the segments as a single line; callers with multi-line
+// content should fill each line from SplitLines separately.
+//
+// Example:
+//
+//	row := Segments{{Text: "> item 2", Style: NewStyle().Bold().Background(Blue)}}
+//	row = row.FillTo(console.Width(), NewStyle().Background(Blue))
+func (s Segments) FillTo(width int, style Style) Segments {
+	extra := width - s.Width()
+	if extra <= 0 {
+		return s
+	}
+	return append(s, Segment{Text: strings.Repeat(" ", extra), Style: style})
+}
+
+// ExpandTabs returns a copy of the segments with each tab replaced by spaces
+// up to the next tab stop, where stops are every tabSize display columns.
+// Columns are counted across segments and restart after each newline, so
+// a tab in one segment lines up with text from the segments before it.
+// A tabSize less than 1 returns the segments unchanged.
+//
+// Example:
+//
+//	segments := Segments{{Text: "a\tb"}, {Text: "cd\te"}}
+//	segments.ExpandTabs(4).String() // "a   bcd e"
+func (s Segments) ExpandTabs(tabSize int) Segments {
+	if tabSize < 1 {
+		return s
+	}
+
+	result := make(Segments, len(s))
+	column := 0
+	for i, seg := range s {
+		result[i] = seg
+		if !strings.Contains(seg.Text, "\t") {
+			if nl := strings.LastIndexByte(seg.Text, '\n'); nl >= 0 {
+				column = DisplayWidth(seg.Text[nl+1:])
+			} else {
+				column += DisplayWidth(seg.Text)
+			}
+			continue
+		}
+
+		var b strings.Builder
+		for text := seg.Text; text != ""; {
+			size, width := nextCluster(text)
+			switch text[0] {
+			case '\t':
+				spaces := tabSize - column%tabSize
+				b.WriteString(strings.Repeat(" ", spaces))
+				column += spaces
+			case '\n':
+				b.WriteByte('\n')
+				column = 0
+			default:
+				b.WriteString(text[:size])
+				column += width
+			}
+			text = text[size:]
+		}
+		result[i].Text = b.String()
+	}
+	return result
+}
+
+// Coalesce returns a copy of the segments with consecutive segments of equal
+// style (see Style.Equal) merged into one, concatenating their text.
+// This reduces fragmentation after transformations like Highlight, and
+// shortens ANSI output since each merged run is styled once.
+//
+// Example:
+//
+//	segments := Segments{
+//		{Text: "a", Style: NewStyle().Bold()},
+//		{Text: "b", Style: NewStyle().Bold()},
+//		{Text: "c", Style: NewStyle()},
+//	}
+//	segments.Coalesce() // {"ab", bold}, {"c", plain}
+func (s Segments) Coalesce() Segments {
+	if len(s) == 0 {
+		return nil
+	}
+
+	result := make(Segments, 0, len(s))
+	result = append(result, s[0])
+
+	for _, seg := range s[1:] {
+		last := &result[len(result)-1]
+		if last.Style.Equal(seg.Style) {
+			last.Text += seg.Text
+			continue
+		}
+		result = append(result, seg)
+	}
+
+	return result
+}
+
 // Join concatenates multiple segment slices into a single Segments slice.
 // This is useful for combining segments from different sources.
 //
@@ -1,6 +1,7 @@
 package rich
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -72,3 +73,234 @@ func TestJoin(t *testing.T) {
 		t.Errorf("Join string = %q, want %q", result.String(), "abc")
 	}
 }
+
+func TestSegments_Highlight(t *testing.T) {
+	base := NewStyle().Italic()
+	highlight := NewStyle().Bold().Foreground(Red)
+	segments := Segments{{Text: "An error, another error", Style: base}}
+
+	result := segments.Highlight("error", highlight)
+
+	want := []string{"An ", "error", ", another ", "error"}
+	if len(result) != len(want) {
+		t.Fatalf("Highlight length = %d, want %d: %v", len(result), len(want), result)
+	}
+	for i, seg := range result {
+		if seg.Text != want[i] {
+			t.Errorf("Segment %d text = %q, want %q", i, seg.Text, want[i])
+		}
+		matched := seg.Text == "error"
+		if seg.Style.bold != matched || (seg.Style.fg != nil) != matched {
+			t.Errorf("Segment %d (%q) highlight = %v, want %v", i, seg.Text, seg.Style.bold, matched)
+		}
+		if !seg.Style.italic {
+			t.Errorf("Segment %d (%q) lost its original style", i, seg.Text)
+		}
+	}
+
+	if result.String() != segments.String() {
+		t.Errorf("Highlight changed text: %q", result.String())
+	}
+}
+
+func TestSegments_HighlightFold(t *testing.T) {
+	segments := Segments{{Text: "Error: ERROR", Style: NewStyle()}}
+
+	if got := segments.Highlight("error", NewStyle().Bold()); len(got) != 1 {
+		t.Errorf("Case-sensitive highlight should not match, got %v", got)
+	}
+
+	got := segments.HighlightFold("error", NewStyle().Bold())
+	if len(got) != 3 || !got[0].Style.bold || got[1].Style.bold || !got[2].Style.bold {
+		t.Errorf("Case-insensitive highlight = %v", got)
+	}
+
+	// Folded matches can differ in byte length from the query
+	tests := []struct {
+		text  string
+		query string
+		want  string
+	}{
+		{"maſs", "SS", "ſs"},        // Long s (2 bytes) folds to s
+		{"5 \u212A", "k", "\u212A"}, // Kelvin sign (3 bytes) folds to k
+		{"über Über", "ÜBER", "über"},
+		{"ka", "\u212Aa", "ka"},
+	}
+	for _, tt := range tests {
+		got := Segments{{Text: tt.text, Style: NewStyle()}}.HighlightFold(tt.query, NewStyle().Bold())
+		if got.String() != tt.text {
+			t.Errorf("HighlightFold(%q) on %q changed the text to %q", tt.query, tt.text, got.String())
+		}
+		var matched []string
+		for _, seg := range got {
+			if seg.Style.bold {
+				matched = append(matched, seg.Text)
+			}
+		}
+		if len(matched) == 0 || matched[0] != tt.want {
+			t.Errorf("HighlightFold(%q) on %q matched %q, want %q first", tt.query, tt.text, matched, tt.want)
+		}
+	}
+}
+
+func TestSegments_SplitLines(t *testing.T) {
+	bold := NewStyle().Bold()
+	segments := Segments{
+		{Text: "Hello\nWor", Style: bold},
+		{Text: "ld\n\nEnd", Style: NewStyle()},
+	}
+
+	lines := segments.SplitLines()
+
+	want := []string{"Hello", "World", "", "End"}
+	if len(lines) != len(want) {
+		t.Fatalf("SplitLines length = %d, want %d", len(lines), len(want))
+	}
+	for i, line := range lines {
+		if line.String() != want[i] {
+			t.Errorf("Line %d = %q, want %q", i, line.String(), want[i])
+		}
+	}
+
+	if !lines[0][0].Style.bold || !lines[1][0].Style.bold || lines[1][1].Style.bold {
+		t.Error("SplitLines should preserve segment styles")
+	}
+
+	if got := (Segments{}).SplitLines(); len(got) != 0 {
+		t.Errorf("Expected no lines for empty segments, got %d", len(got))
+	}
+}
+
+func TestSegments_Coalesce(t *testing.T) {
+	bold := NewStyle().Bold()
+	red := NewStyle().Foreground(Red)
+
+	segments := Segments{
+		{Text: "a", Style: bold},
+		{Text: "b", Style: NewStyle().Bold()},
+		{Text: "c", Style: bold},
+		{Text: "d", Style: red},
+		{Text: "e", Style: bold},
+	}
+
+	got := segments.Coalesce()
+
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 segments, got %d: %v", len(got), got)
+	}
+	if got[0].Text != "abc" || !got[0].Style.Equal(bold) {
+		t.Errorf("Expected first segment 'abc' in bold, got %q", got[0].Text)
+	}
+	if got[1].Text != "d" || !got[1].Style.Equal(red) {
+		t.Errorf("Expected second segment 'd' in red, got %q", got[1].Text)
+	}
+	if got[2].Text != "e" {
+		t.Errorf("Expected third segment 'e', got %q", got[2].Text)
+	}
+
+	// The input is not modified
+	if segments[0].Text != "a" {
+		t.Errorf("Coalesce modified its input: %q", segments[0].Text)
+	}
+
+	if Segments(nil).Coalesce() != nil {
+		t.Error("Expected nil for empty segments")
+	}
+}
+
+func TestSegments_Width(t *testing.T) {
+	segments := Segments{
+		{Text: "Hi ", Style: NewStyle()},
+		{Text: "世界", Style: NewStyle().Bold()},
+	}
+
+	if got := segments.Width(); got != 7 {
+		t.Errorf("Width() = %d, want 7", got)
+	}
+	if got := segments.Length(); got != 5 {
+		t.Errorf("Length() = %d, want 5", got)
+	}
+}
+
+func TestSegments_ToANSICoalescesStyles(t *testing.T) {
+	red := NewStyle().Foreground(Red)
+
+	// Markup like "[red]a[/][red]b[/]" produces adjacent equal styles
+	segments, err := parseMarkup("[red]a[/][red]b[/]c")
+	if err != nil {
+		t.Fatalf("parseMarkup returned error: %v", err)
+	}
+
+	got := segments.ToANSI(ColorModeStandard)
+	want := "\x1b[31mab\x1b[0mc"
+	if got != want {
+		t.Errorf("ToANSI = %q, want %q", got, want)
+	}
+
+	// One style and one reset instead of one of each per segment
+	repeated := Segments{{Text: "x", Style: red}, {Text: "y", Style: red}, {Text: "z", Style: red}}
+	if n := strings.Count(repeated.ToANSI(ColorModeStandard), "\x1b["); n != 2 {
+		t.Errorf("Expected 2 escape sequences for a repeated style, got %d", n)
+	}
+
+	// Different styles still reset between runs
+	mixed := Segments{{Text: "a", Style: red}, {Text: "b", Style: NewStyle().Bold()}}
+	if got := mixed.ToANSI(ColorModeStandard); got != "\x1b[31ma\x1b[0m\x1b[1mb\x1b[0m" {
+		t.Errorf("ToANSI = %q", got)
+	}
+}
+
+func TestSegments_FillTo(t *testing.T) {
+	bg := NewStyle().Background(Blue)
+	segments := Segments{{Text: "日本", Style: NewStyle().Bold()}}
+
+	filled := segments.FillTo(8, bg)
+	if filled.Width() != 8 {
+		t.Errorf("Width() = %d, want 8", filled.Width())
+	}
+	last := filled[len(filled)-1]
+	if last.Text != "    " || !last.Style.Equal(bg) {
+		t.Errorf("Padding = %q (%v), want 4 spaces on blue", last.Text, last.Style)
+	}
+
+	// The padding carries the background's escape codes
+	bgCode := bg.toANSI(ColorModeStandard)
+	if got := filled.ToANSI(ColorModeStandard); !strings.Contains(got, bgCode+"    ") {
+		t.Errorf("ToANSI() = %q, want padding styled with %q", got, bgCode)
+	}
+
+	// Lines that already fill the width are unchanged
+	if got := segments.FillTo(3, bg); len(got) != 1 {
+		t.Errorf("Expected no padding for a wide line, got %v", got)
+	}
+}
+
+func TestSegments_ExpandTabs(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments Segments
+		tabSize  int
+		want     string
+	}{
+		{"leading tab", Segments{{Text: "\tx"}}, 4, "    x"},
+		{"stops every 4", Segments{{Text: "a\tbc\tdef\tg"}}, 4, "a   bc  def g"},
+		{"full stop", Segments{{Text: "abcd\te"}}, 4, "abcd    e"},
+		{"across segments", Segments{{Text: "a\tb"}, {Text: "cd\te"}}, 4, "a   bcd e"},
+		{"after newline", Segments{{Text: "abc\n\tx"}}, 8, "abc\n        x"},
+		{"newline in earlier segment", Segments{{Text: "abcdef\nab"}, {Text: "\tx"}}, 4, "abcdef\nab  x"},
+		{"wide characters", Segments{{Text: "日本\tx"}}, 8, "日本    x"},
+		{"disabled", Segments{{Text: "a\tb"}}, 0, "a\tb"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.segments.ExpandTabs(tt.tabSize).String(); got != tt.want {
+			t.Errorf("%s: ExpandTabs(%d) = %q, want %q", tt.name, tt.tabSize, got, tt.want)
+		}
+	}
+
+	// Styles are kept
+	styled := Segments{{Text: "\tx", Style: NewStyle().Bold()}}.ExpandTabs(2)
+	if !styled[0].Style.IsBold() {
+		t.Errorf("Expected style to be preserved, got %v", styled[0].Style)
+	}
+}
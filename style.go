@@ -1,5 +1,10 @@
 package rich
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Style represents an immutable text style with colors and formatting attributes.
 // Styles are created using a fluent builder pattern, where each method returns
 // a new Style with the specified attribute enabled. This allows for easy chaining:
@@ -14,6 +19,7 @@ package rich
 type Style struct {
 	fg            Color // Foreground (text) color
 	bg            Color // Background color
+	ul            Color // Underline color (SGR 58), used when underline is on
 	bold          bool  // Bold/bright text (SGR 1)
 	italic        bool  // Italic text (SGR 3)
 	underline     bool  // Underlined text (SGR 4)
@@ -90,6 +96,23 @@ func (s Style) Underline() Style {
 	return s
 }
 
+// UnderlineColor returns a new style with the specified underline color and
+// underline enabled. Terminals that support it (kitty, WezTerm, iTerm2, VTE)
+// draw the underline in this color, independent of the text color; others
+// ignore it and draw the underline in the text color.
+//
+// The underline color requires 256-color or true color mode; it is omitted
+// in ColorModeStandard and ColorModeNone.
+//
+// Example:
+//
+//	style := NewStyle().UnderlineColor(rich.RGB(255, 0, 0)) // red squiggle-style highlight
+func (s Style) UnderlineColor(color Color) Style {
+	s.ul = color
+	s.underline = true
+	return s
+}
+
 // Strikethrough returns a new style with strikethrough enabled.
 // Uses ANSI SGR code 9. Draws a line through the middle of the text.
 // Not all terminals support this attribute.
@@ -126,6 +149,23 @@ func (s Style) Reverse() Style {
 	return s
 }
 
+// AutoContrast returns a new style whose foreground is black or white,
+// whichever is more legible on the style's background (see ContrastColor).
+// The background must already be set: without one, the style is returned
+// unchanged.
+//
+// Example:
+//
+//	// Labels with data-derived background colors
+//	style := NewStyle().Background(rich.RGB(r, g, b)).AutoContrast()
+func (s Style) AutoContrast() Style {
+	if s.bg == nil {
+		return s
+	}
+	s.fg = ContrastColor(s.bg)
+	return s
+}
+
 // Render applies this style to the given text, creating StyledText.
 // This is a convenience method for creating styled text that can be
 // printed using Console.PrintStyled or Console.PrintStyledln.
@@ -148,6 +188,9 @@ func (s Style) FgColor() Color { return s.fg }
 // BgColor returns the background color, or nil if none is set.
 func (s Style) BgColor() Color { return s.bg }
 
+// UlColor returns the underline color, or nil if none is set.
+func (s Style) UlColor() Color { return s.ul }
+
 // IsBold reports whether bold is enabled.
 func (s Style) IsBold() bool { return s.bold }
 
@@ -177,6 +220,95 @@ func (s Style) WithFg(c Color) Style { s.fg = c; return s }
 // Passing nil clears the background color.
 func (s Style) WithBg(c Color) Style { s.bg = c; return s }
 
+// Combine returns a new style with other layered on top of this style.
+// Colors set in other replace this style's colors, and attributes enabled
+// in either style are enabled in the result.
+//
+// Example:
+//
+//	base := NewStyle().Foreground(Red)
+//	combined := base.Combine(NewStyle().Bold()) // bold red
+func (s Style) Combine(other Style) Style {
+	if other.fg != nil {
+		s.fg = other.fg
+	}
+	if other.bg != nil {
+		s.bg = other.bg
+	}
+	if other.ul != nil {
+		s.ul = other.ul
+	}
+	s.bold = s.bold || other.bold
+	s.italic = s.italic || other.italic
+	s.underline = s.underline || other.underline
+	s.strikethrough = s.strikethrough || other.strikethrough
+	s.dim = s.dim || other.dim
+	s.reverse = s.reverse || other.reverse
+	return s
+}
+
+// Equal reports whether two styles have the same colors and attributes.
+// Colors are equal when they are the same type and value, so Red (ANSIColor)
+// and RGB(255, 0, 0) are different colors.
+//
+// Example:
+//
+//	NewStyle().Bold().Equal(NewStyle().Bold()) // true
+func (s Style) Equal(other Style) bool {
+	return ColorEqual(s.fg, other.fg) &&
+		ColorEqual(s.bg, other.bg) &&
+		ColorEqual(s.ul, other.ul) &&
+		s.bold == other.bold &&
+		s.italic == other.italic &&
+		s.underline == other.underline &&
+		s.strikethrough == other.strikethrough &&
+		s.dim == other.dim &&
+		s.reverse == other.reverse
+}
+
+// String describes the style for debugging and test failure messages.
+// Enabled attributes come first, followed by the foreground color and
+// "on <background>"; an underline color is shown as "underline(<color>)".
+// An empty style is described as "none".
+//
+// Example:
+//
+//	NewStyle().Bold().Foreground(Red).Background(Blue).String()
+//	// "bold red on blue"
+func (s Style) String() string {
+	var parts []string
+	for _, attr := range []struct {
+		on   bool
+		name string
+	}{
+		{s.bold, "bold"},
+		{s.dim, "dim"},
+		{s.italic, "italic"},
+		{s.underline, "underline"},
+		{s.strikethrough, "strikethrough"},
+		{s.reverse, "reverse"},
+	} {
+		if attr.on {
+			parts = append(parts, attr.name)
+		}
+	}
+
+	if s.ul != nil {
+		parts = append(parts, fmt.Sprintf("underline(%v)", s.ul))
+	}
+	if s.fg != nil {
+		parts = append(parts, fmt.Sprint(s.fg))
+	}
+	if s.bg != nil {
+		parts = append(parts, "on", fmt.Sprint(s.bg))
+	}
+
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, " ")
+}
+
 // toANSI generates the ANSI escape sequence for this style.
 // Returns an empty string if the color mode is ColorModeNone.
 //
@@ -194,6 +326,7 @@ func (s Style) WithBg(c Color) Style { s.bg = c; return s }
 //   - 4: Underline
 //   - 7: Reverse video
 //   - 9: Strikethrough
+//   - 58: Underline color (see underlineColorANSI)
 func (s Style) toANSI(mode ColorMode) string {
 	// No styling in ColorModeNone
 	if mode == ColorModeNone {
@@ -242,9 +375,76 @@ func (s Style) toANSI(mode ColorMode) string {
 		seq += s.bg.toANSI(mode, false)
 	}
 
+	// Append underline color sequence (if set and underlined)
+	if s.ul != nil && s.underline {
+		seq += underlineColorANSI(s.ul, mode)
+	}
+
 	return seq
 }
 
+// underlineColorANSI generates the SGR 58 sequence that sets the underline color.
+// There is no 16-color form of SGR 58, so standard colors use their 256-color
+// palette index. Returns an empty string in ColorModeStandard and ColorModeNone,
+// where underline colors aren't reliably supported.
+//
+// The underline color is cleared by SGR 0 (full reset) or SGR 59.
+func underlineColorANSI(c Color, mode ColorMode) string {
+	if mode != ColorMode256 && mode != ColorModeTrueColor {
+		return ""
+	}
+
+	switch v := c.(type) {
+	case RGBColor:
+		if mode == ColorMode256 {
+			return fmt.Sprintf("\x1b[58;5;%dm", int(v.toANSI256()))
+		}
+		return fmt.Sprintf("\x1b[58;2;%d;%d;%dm", v.R, v.G, v.B)
+	case ANSI256Color:
+		return fmt.Sprintf("\x1b[58;5;%dm", int(v))
+	case ANSIColor:
+		return fmt.Sprintf("\x1b[58;5;%dm", int(v))
+	}
+	return ""
+}
+
+// ThresholdStyle returns a green, yellow, or red foreground style depending
+// on where value falls relative to the warn and bad thresholds. It's a small
+// helper for status columns, dashboards, and progress bars.
+//
+// When ascending is true, larger values are worse (e.g. CPU usage):
+//   - value < warn: green
+//   - warn <= value < bad: yellow
+//   - value >= bad: red
+//
+// When ascending is false, smaller values are worse (e.g. free memory), and
+// the comparisons are reversed: value > warn is green, value <= bad is red,
+// and anything in between is yellow.
+//
+// Example:
+//
+//	cpu := 87.5
+//	style := rich.ThresholdStyle(cpu, 70, 90, true) // yellow
+//	console.PrintStyledln(style.Render(fmt.Sprintf("CPU %.1f%%", cpu)))
+//
+//	free := 8.0
+//	style = rich.ThresholdStyle(free, 20, 10, false) // red
+func ThresholdStyle(value, warn, bad float64, ascending bool) Style {
+	if !ascending {
+		// Negate everything so the ascending comparisons apply
+		value, warn, bad = -value, -warn, -bad
+	}
+
+	switch {
+	case value >= bad:
+		return NewStyle().Foreground(Red)
+	case value >= warn:
+		return NewStyle().Foreground(Yellow)
+	default:
+		return NewStyle().Foreground(Green)
+	}
+}
+
 // StyledText represents text with an associated style.
 // This is typically created using Style.Render() and can be printed
 // using Console.PrintStyled() or Console.PrintStyledln().
@@ -104,3 +104,136 @@ func TestStyleToANSI(t *testing.T) {
 		})
 	}
 }
+
+func TestStyleUnderlineColor(t *testing.T) {
+	style := NewStyle().UnderlineColor(RGB(255, 0, 0))
+
+	if !style.IsUnderline() {
+		t.Error("Expected UnderlineColor to enable underline")
+	}
+
+	tests := []struct {
+		mode ColorMode
+		want string
+	}{
+		{ColorModeTrueColor, "\x1b[4m\x1b[58;2;255;0;0m"},
+		{ColorMode256, "\x1b[4m\x1b[58;5;196m"},
+		{ColorModeStandard, "\x1b[4m"},
+		{ColorModeNone, ""},
+	}
+
+	for _, tt := range tests {
+		if got := style.toANSI(tt.mode); got != tt.want {
+			t.Errorf("Mode %v: toANSI() = %q, want %q", tt.mode, got, tt.want)
+		}
+	}
+
+	// Standard colors use their palette index
+	if got := NewStyle().UnderlineColor(Red).toANSI(ColorModeTrueColor); got != "\x1b[4m\x1b[58;5;1m" {
+		t.Errorf("toANSI() = %q, want underline color index 1", got)
+	}
+}
+
+func TestThresholdStyle(t *testing.T) {
+	green := NewStyle().Foreground(Green)
+	yellow := NewStyle().Foreground(Yellow)
+	red := NewStyle().Foreground(Red)
+
+	tests := []struct {
+		name      string
+		value     float64
+		warn, bad float64
+		ascending bool
+		want      Style
+	}{
+		{"ascending below warn", 50, 70, 90, true, green},
+		{"ascending at warn", 70, 70, 90, true, yellow},
+		{"ascending between", 80, 70, 90, true, yellow},
+		{"ascending at bad", 90, 70, 90, true, red},
+		{"ascending above bad", 99, 70, 90, true, red},
+		{"descending above warn", 50, 20, 10, false, green},
+		{"descending between", 15, 20, 10, false, yellow},
+		{"descending at bad", 10, 20, 10, false, red},
+		{"descending below bad", 5, 20, 10, false, red},
+	}
+
+	for _, tt := range tests {
+		got := ThresholdStyle(tt.value, tt.warn, tt.bad, tt.ascending)
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: ThresholdStyle(%v, %v, %v, %v) = %+v, want %+v",
+				tt.name, tt.value, tt.warn, tt.bad, tt.ascending, got, tt.want)
+		}
+	}
+}
+
+func TestStyleEqual(t *testing.T) {
+	base := NewStyle().Bold().Foreground(Red).Background(RGB(0, 0, 255))
+
+	if !base.Equal(NewStyle().Bold().Foreground(Red).Background(RGB(0, 0, 255))) {
+		t.Error("Expected identically built styles to be equal")
+	}
+	if !NewStyle().Equal(Style{}) {
+		t.Error("Expected NewStyle() to equal the zero Style")
+	}
+
+	different := []struct {
+		name  string
+		other Style
+	}{
+		{"attribute", base.Italic()},
+		{"foreground value", base.Foreground(Blue)},
+		{"foreground type", base.Foreground(ANSI256Color(1))},
+		{"background type", base.Background(Blue)},
+		{"underline color", base.UnderlineColor(Red)},
+		{"missing foreground", NewStyle().Bold().Background(RGB(0, 0, 255))},
+	}
+	for _, tt := range different {
+		if base.Equal(tt.other) {
+			t.Errorf("%s: expected %v and %v to differ", tt.name, base, tt.other)
+		}
+	}
+}
+
+func TestStyleString(t *testing.T) {
+	tests := []struct {
+		style Style
+		want  string
+	}{
+		{NewStyle(), "none"},
+		{NewStyle().Bold(), "bold"},
+		{NewStyle().Italic().Bold().Dim(), "bold dim italic"},
+		{NewStyle().Foreground(Red), "red"},
+		{NewStyle().Background(Blue), "on blue"},
+		{NewStyle().Bold().Foreground(Red).Background(Blue), "bold red on blue"},
+		{NewStyle().Foreground(ANSI256Color(208)).Background(RGB(0, 0, 0)), "color(208) on #000000"},
+		{NewStyle().Strikethrough().Reverse(), "strikethrough reverse"},
+		{NewStyle().UnderlineColor(RGB(255, 0, 0)), "underline underline(#ff0000)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.style.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestStyleAutoContrast(t *testing.T) {
+	dark := NewStyle().Bold().Background(RGB(10, 10, 80)).AutoContrast()
+	if !ColorEqual(dark.FgColor(), RGB(255, 255, 255)) {
+		t.Errorf("Expected white text on a dark background, got %v", dark.FgColor())
+	}
+	if !dark.IsBold() {
+		t.Error("Expected other attributes to be kept")
+	}
+
+	light := NewStyle().Foreground(Red).Background(ANSI256Color(229)).AutoContrast()
+	if !ColorEqual(light.FgColor(), RGB(0, 0, 0)) {
+		t.Errorf("Expected black text on a light background, got %v", light.FgColor())
+	}
+
+	// Without a background there is nothing to contrast with
+	plain := NewStyle().Foreground(Red).AutoContrast()
+	if !ColorEqual(plain.FgColor(), Red) {
+		t.Errorf("Expected foreground unchanged without a background, got %v", plain.FgColor())
+	}
+}
@@ -0,0 +1,200 @@
+package syntax
+
+import "strings"
+
+// tokenKind classifies a token for styling.
+type tokenKind int
+
+const (
+	tokenPlain tokenKind = iota
+	tokenKeyword
+	tokenString
+	tokenComment
+	tokenNumber
+)
+
+// token is a run of source text of a single kind.
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// language describes the lexical rules the tokenizer needs.
+type language struct {
+	keywords     map[string]bool
+	lineComment  string // Line comment prefix ("" = none)
+	blockComment [2]string
+	quotes       string // Characters that start escaped, single-line strings
+	rawQuotes    string // Characters that start raw, multi-line strings
+}
+
+// languages maps language names to their lexical rules.
+// Unknown languages (including "plain") get no highlighting.
+var languages = map[string]*language{
+	"go":     goLanguage,
+	"golang": goLanguage,
+	"json":   jsonLanguage,
+}
+
+var goLanguage = &language{
+	keywords: wordSet(
+		"break case chan const continue default defer else fallthrough for " +
+			"func go goto if import interface map package range return select " +
+			"struct switch type var true false nil iota"),
+	lineComment:  "//",
+	blockComment: [2]string{"/*", "*/"},
+	quotes:       `"'`,
+	rawQuotes:    "`",
+}
+
+var jsonLanguage = &language{
+	keywords: wordSet("true false null"),
+	quotes:   `"`,
+}
+
+// wordSet builds a keyword set from a space-separated list.
+func wordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// tokenize splits code into tokens. A nil language yields a single plain
+// token. Adjacent plain text is merged into one token.
+func tokenize(code string, lang *language) []token {
+	if lang == nil {
+		if code == "" {
+			return nil
+		}
+		return []token{{kind: tokenPlain, text: code}}
+	}
+
+	var tokens []token
+	add := func(kind tokenKind, text string) {
+		if n := len(tokens); n > 0 && kind == tokenPlain && tokens[n-1].kind == tokenPlain {
+			tokens[n-1].text += text
+			return
+		}
+		tokens = append(tokens, token{kind: kind, text: text})
+	}
+
+	for i := 0; i < len(code); {
+		rest := code[i:]
+		c := code[i]
+
+		switch {
+		case lang.lineComment != "" && strings.HasPrefix(rest, lang.lineComment):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			add(tokenComment, rest[:end])
+			i += end
+
+		case lang.blockComment[0] != "" && strings.HasPrefix(rest, lang.blockComment[0]):
+			end := strings.Index(rest[len(lang.blockComment[0]):], lang.blockComment[1])
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += len(lang.blockComment[0]) + len(lang.blockComment[1])
+			}
+			add(tokenComment, rest[:end])
+			i += end
+
+		case strings.IndexByte(lang.rawQuotes, c) >= 0:
+			end := strings.IndexByte(rest[1:], c)
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += 2
+			}
+			add(tokenString, rest[:end])
+			i += end
+
+		case strings.IndexByte(lang.quotes, c) >= 0:
+			end := scanString(rest)
+			add(tokenString, rest[:end])
+			i += end
+
+		case isDigit(c) || (c == '.' && len(rest) > 1 && isDigit(rest[1])):
+			end := scanNumber(rest)
+			add(tokenNumber, rest[:end])
+			i += end
+
+		case isIdentStart(c):
+			end := 1
+			for end < len(rest) && isIdentPart(rest[end]) {
+				end++
+			}
+			word := rest[:end]
+			if lang.keywords[word] {
+				add(tokenKeyword, word)
+			} else {
+				add(tokenPlain, word)
+			}
+			i += end
+
+		default:
+			add(tokenPlain, rest[:1])
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// scanString returns the length of the quoted string at the start of s,
+// honoring backslash escapes. An unterminated string ends at the newline.
+func scanString(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) && s[i+1] != '\n' {
+				i++
+			}
+		case quote:
+			return i + 1
+		case '\n':
+			return i
+		}
+	}
+	return len(s)
+}
+
+// scanNumber returns the length of the numeric literal at the start of s.
+// It accepts decimal, hex, octal, and binary forms, digit separators,
+// fractions, exponents (with sign), and the imaginary suffix.
+func scanNumber(s string) int {
+	hex := len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case isIdentPart(c) || c == '.':
+			i++
+		case c == '+' || c == '-':
+			// A sign is only part of the number right after an exponent marker;
+			// in hex literals 'e' is a digit and only 'p' marks the exponent
+			prev := s[i-1]
+			if prev != 'p' && prev != 'P' && (hex || (prev != 'e' && prev != 'E')) {
+				return i
+			}
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentPart(c byte) bool { return isIdentStart(c) || isDigit(c) }
@@ -0,0 +1,159 @@
+// Package syntax renders source code with syntax highlighting.
+//
+// Highlighting is done by a small tokenizer that recognizes keywords,
+// string literals, comments, and numbers. It's intentionally minimal - it
+// doesn't parse the language - but it handles strings and comments
+// correctly, including escapes, raw strings, and block comments.
+//
+// Supported languages:
+//   - "go" (also "golang")
+//   - "json"
+//   - "plain" (or any unrecognized name): no highlighting
+//
+// # Basic Usage
+//
+//	code := `func main() {
+//		fmt.Println("Hello")
+//	}`
+//	console.Renderln(syntax.New(code, "go"))
+//
+// Colors come from a Theme, which can be replaced:
+//
+//	theme := syntax.DefaultTheme
+//	theme.Keyword = rich.NewStyle().Foreground(rich.Magenta).Bold()
+//	console.Renderln(syntax.New(code, "go").Theme(theme))
+package syntax
+
+import (
+	"strings"
+
+	"github.com/eberle1080/go-rich"
+)
+
+// Theme defines the style for each kind of token.
+type Theme struct {
+	Plain   rich.Style // Identifiers, operators, and whitespace
+	Keyword rich.Style // Language keywords and literal constants (true, nil, ...)
+	String  rich.Style // String and character literals
+	Comment rich.Style // Line and block comments
+	Number  rich.Style // Numeric literals
+}
+
+// DefaultTheme is the theme used by New: magenta keywords, green strings,
+// dim italic comments, and cyan numbers.
+var DefaultTheme = Theme{
+	Plain:   rich.NewStyle(),
+	Keyword: rich.NewStyle().Foreground(rich.Magenta).Bold(),
+	String:  rich.NewStyle().Foreground(rich.Green),
+	Comment: rich.NewStyle().Dim().Italic(),
+	Number:  rich.NewStyle().Foreground(rich.Cyan),
+}
+
+// Syntax is a renderable that displays source code with syntax highlighting.
+// Create one with New.
+type Syntax struct {
+	code    string // Source code to display
+	lang    string // Language name, lowercased
+	theme   Theme  // Token styles
+	tabSize int    // Columns per tab stop
+}
+
+// New creates a Syntax renderable for code in the given language.
+// The language name is case-insensitive; unknown languages are displayed
+// without highlighting. Tabs are expanded to 4-column stops.
+//
+// Example:
+//
+//	console.Renderln(syntax.New(`{"ok": true}`, "json"))
+func New(code, lang string) *Syntax {
+	return &Syntax{
+		code:    code,
+		lang:    strings.ToLower(lang),
+		theme:   DefaultTheme,
+		tabSize: 4,
+	}
+}
+
+// Supported reports whether lang (case-insensitive) has highlighting rules.
+// Unsupported languages can still be rendered; they just aren't highlighted.
+//
+// Example:
+//
+//	if syntax.Supported(lang) {
+//		console.Renderln(syntax.New(code, lang))
+//	}
+func Supported(lang string) bool {
+	return languages[strings.ToLower(lang)] != nil
+}
+
+// Theme sets the token styles.
+// Default is DefaultTheme.
+//
+// Example:
+//
+//	syntax.New(code, "go").Theme(myTheme)
+func (s *Syntax) Theme(theme Theme) *Syntax {
+	s.theme = theme
+	return s
+}
+
+// TabSize sets the number of columns between tab stops.
+// Values less than 1 are ignored. Default is 4.
+//
+// Example:
+//
+//	syntax.New(code, "go").TabSize(8)
+func (s *Syntax) TabSize(size int) *Syntax {
+	if size > 0 {
+		s.tabSize = size
+	}
+	return s
+}
+
+// Render implements rich.Renderable.
+// Lines are not wrapped; a trailing newline in the code is dropped.
+func (s *Syntax) Render(console *rich.Console, width int) rich.Segments {
+	code := expandTabs(strings.TrimSuffix(s.code, "\n"), s.tabSize)
+
+	var segments rich.Segments
+	for _, tok := range tokenize(code, languages[s.lang]) {
+		style := s.theme.Plain
+		switch tok.kind {
+		case tokenKeyword:
+			style = s.theme.Keyword
+		case tokenString:
+			style = s.theme.String
+		case tokenComment:
+			style = s.theme.Comment
+		case tokenNumber:
+			style = s.theme.Number
+		}
+		segments = append(segments, rich.Segment{Text: tok.text, Style: style})
+	}
+	return segments
+}
+
+// expandTabs replaces tabs with spaces up to the next tab stop.
+func expandTabs(code string, size int) string {
+	if !strings.Contains(code, "\t") {
+		return code
+	}
+
+	var b strings.Builder
+	column := 0
+	for _, r := range code {
+		switch r {
+		case '\t':
+			n := size - column%size
+			b.WriteString(strings.Repeat(" ", n))
+			column += n
+		case '\n':
+			b.WriteRune(r)
+			column = 0
+		default:
+			b.WriteRune(r)
+			column += rich.RuneWidth(r)
+		}
+	}
+	return b.String()
+}
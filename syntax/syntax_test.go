@@ -0,0 +1,108 @@
+package syntax
+
+import (
+	"testing"
+
+	"github.com/eberle1080/go-rich"
+)
+
+// styleOf returns the style of the first segment with the given text.
+func styleOf(t *testing.T, segments rich.Segments, text string) rich.Style {
+	t.Helper()
+	for _, seg := range segments {
+		if seg.Text == text {
+			return seg.Style
+		}
+	}
+	t.Fatalf("Segment %q not found in %q", text, segments.String())
+	return rich.Style{}
+}
+
+func TestSyntaxGo(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	code := "package main\n\n// Say hi\nfunc main() {\n\tmsg := \"say \\\"hi\\\" // not a comment\"\n\tn := 0x1F + 1.5e-3\n\treturn `raw\nstring`\n}\n"
+	segments := New(code, "go").Render(console, 80)
+
+	if styleOf(t, segments, "func").Equal(styleOf(t, segments, `"say \"hi\" // not a comment"`)) {
+		t.Error("Keywords and strings should have distinct styles")
+	}
+
+	tests := []struct {
+		text string
+		want rich.Style
+	}{
+		{"package", DefaultTheme.Keyword},
+		{"func", DefaultTheme.Keyword},
+		{"return", DefaultTheme.Keyword},
+		{`"say \"hi\" // not a comment"`, DefaultTheme.String},
+		{"`raw\nstring`", DefaultTheme.String},
+		{"// Say hi", DefaultTheme.Comment},
+		{"0x1F", DefaultTheme.Number},
+		{"1.5e-3", DefaultTheme.Number},
+	}
+	for _, tt := range tests {
+		if got := styleOf(t, segments, tt.text); !got.Equal(tt.want) {
+			t.Errorf("%q has style %+v, want %+v", tt.text, got, tt.want)
+		}
+	}
+
+	// Tabs are expanded and the text is otherwise unchanged
+	if got := segments.String(); got != expandTabs(code[:len(code)-1], 4) {
+		t.Errorf("Rendered text changed: %q", got)
+	}
+}
+
+func TestSyntaxJSON(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	segments := New(`{"a": [1, true, null, "x"]}`, "JSON").Render(console, 80)
+
+	if got := styleOf(t, segments, `"a"`); !got.Equal(DefaultTheme.String) {
+		t.Errorf("Expected string style, got %+v", got)
+	}
+	if got := styleOf(t, segments, "true"); !got.Equal(DefaultTheme.Keyword) {
+		t.Errorf("Expected keyword style, got %+v", got)
+	}
+	if got := styleOf(t, segments, "1"); !got.Equal(DefaultTheme.Number) {
+		t.Errorf("Expected number style, got %+v", got)
+	}
+}
+
+func TestSyntaxPlainAndTheme(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	segments := New("func main() {}", "plain").Render(console, 80)
+	if len(segments) != 1 || !segments[0].Style.Equal(DefaultTheme.Plain) {
+		t.Errorf("Expected a single plain segment, got %+v", segments)
+	}
+
+	theme := DefaultTheme
+	theme.Keyword = rich.NewStyle().Foreground(rich.Red)
+	segments = New("var x", "go").Theme(theme).Render(console, 80)
+	if got := styleOf(t, segments, "var"); !got.Equal(theme.Keyword) {
+		t.Errorf("Expected custom keyword style, got %+v", got)
+	}
+}
+
+func TestSyntaxUnterminated(t *testing.T) {
+	tokens := tokenize("x := \"open\ny /* never closed", goLanguage)
+
+	if tokens[1].text != "\"open" || tokens[1].kind != tokenString {
+		t.Errorf("Unterminated string should end at the newline, got %+v", tokens[1])
+	}
+	if last := tokens[len(tokens)-1]; last.kind != tokenComment || last.text != "/* never closed" {
+		t.Errorf("Unterminated block comment should run to the end, got %+v", last)
+	}
+}
+
+func TestSupported(t *testing.T) {
+	for _, lang := range []string{"go", "Golang", "json"} {
+		if !Supported(lang) {
+			t.Errorf("Supported(%q) = false, want true", lang)
+		}
+	}
+	if Supported("plain") || Supported("cobol") {
+		t.Error("Expected plain and unknown languages to be unsupported")
+	}
+}
@@ -1,5 +1,10 @@
 package table
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Box defines the characters used to draw table borders.
 // Each field represents a specific position or edge in the table structure.
 // By customizing these characters, you can create tables with different visual styles.
@@ -16,6 +21,9 @@ package table
 //	│                               │
 //	BottomLeft ──Bottom── MidBottom ──Bottom── BottomRight
 //
+// Columns are separated by the Left character unless ColumnSeparator is set,
+// which lets the inner lines differ from the outer edge (see BoxThickEdge).
+//
 // The package provides predefined box styles (BoxSimple, BoxRounded, BoxDouble, etc.)
 // for common use cases.
 type Box struct {
@@ -30,8 +38,8 @@ type Box struct {
 	Bottom      string // Bottom edge character (repeated horizontally)
 	BottomRight string // Bottom-right corner character
 
-	MidLeft   string // Left T-junction (where header separator meets left edge)
-	MidRight  string // Right T-junction (where header separator meets right edge)
+	MidLeft   string // Left T-junction (where a row line meets the left edge)
+	MidRight  string // Right T-junction (where a row line meets the right edge)
 	MidTop    string // Top T-junction (where column separator meets top edge)
 	MidBottom string // Bottom T-junction (where column separator meets bottom edge)
 	Mid       string // Cross junction (where header separator meets column separator)
@@ -39,6 +47,8 @@ type Box struct {
 	HeaderRow   string // Header separator row character (repeated horizontally)
 	HeaderLeft  string // Header separator left junction
 	HeaderRight string // Header separator right junction
+
+	ColumnSeparator string // Vertical line between columns (optional; Left is used if empty)
 }
 
 // Predefined box styles.
@@ -189,8 +199,71 @@ var (
 		HeaderRight: "┤",
 	}
 
+	// BoxDashed uses light dashed lines for subtle separation.
+	// The dashed edges and separators recede more than solid lines, which
+	// keeps dense tables readable without heavy grid lines. Corners and
+	// junctions are the light solid glyphs, as there are no dashed ones.
+	//
+	// Example:
+	//   ┌╌╌╌╌╌╌┬╌╌╌╌╌╌┐
+	//   ╎ Name ╎ Age  ╎
+	//   ├╌╌╌╌╌╌┼╌╌╌╌╌╌┤
+	//   ╎ Bob  ╎ 25   ╎
+	//   └╌╌╌╌╌╌┴╌╌╌╌╌╌┘
+	BoxDashed = Box{
+		TopLeft:     "┌",
+		Top:         "╌",
+		TopRight:    "┐",
+		Left:        "╎",
+		Right:       "╎",
+		BottomLeft:  "└",
+		Bottom:      "╌",
+		BottomRight: "┘",
+		MidLeft:     "├",
+		MidRight:    "┤",
+		MidTop:      "┬",
+		MidBottom:   "┴",
+		Mid:         "┼",
+		HeaderRow:   "╌",
+		HeaderLeft:  "├",
+		HeaderRight: "┤",
+	}
+
+	// BoxThickEdge frames the table with a heavy outer edge and light inner lines.
+	// The outside stands out as in BoxHeavy while the separators between
+	// columns and rows stay thin, using the mixed heavy/light junction glyphs
+	// where the two meet.
+	//
+	// Example:
+	//   ┏━━━━━━┯━━━━━━┓
+	//   ┃ Name │ Age  ┃
+	//   ┠──────┼──────┨
+	//   ┃ Bob  │ 25   ┃
+	//   ┗━━━━━━┷━━━━━━┛
+	BoxThickEdge = Box{
+		TopLeft:         "┏",
+		Top:             "━",
+		TopRight:        "┓",
+		Left:            "┃",
+		Right:           "┃",
+		BottomLeft:      "┗",
+		Bottom:          "━",
+		BottomRight:     "┛",
+		MidLeft:         "┠",
+		MidRight:        "┨",
+		MidTop:          "┯",
+		MidBottom:       "┷",
+		Mid:             "┼",
+		HeaderRow:       "─",
+		HeaderLeft:      "┠",
+		HeaderRight:     "┨",
+		ColumnSeparator: "│",
+	}
+
 	// BoxNone has no borders at all.
 	// This style removes all border characters, creating a borderless table.
+	// Columns are still separated by a single space, and no blank lines are
+	// left where horizontal borders would be drawn.
 	// Useful for simple data display where visual separation isn't needed,
 	// or when the table is part of a larger bordered container.
 	//
@@ -199,3 +272,118 @@ var (
 	//   Bob    25
 	BoxNone = Box{}
 )
+
+// NewBox returns a copy of BoxSimple to use as the starting point for a custom box.
+// Replace characters with the With* methods, then check the result with Validate.
+// Each method returns a modified copy, so boxes can be derived from one another.
+//
+// Example:
+//
+//	box := table.NewBox().
+//		WithCorners("╔", "╗", "╚", "╝").
+//		WithEdges("═", "║")
+//	if err := box.Validate(); err != nil {
+//		log.Fatal(err)
+//	}
+//	tbl := table.New().Box(box)
+func NewBox() Box {
+	return BoxSimple
+}
+
+// WithCorners returns a copy of the box with the four outer corners replaced.
+func (b Box) WithCorners(topLeft, topRight, bottomLeft, bottomRight string) Box {
+	b.TopLeft = topLeft
+	b.TopRight = topRight
+	b.BottomLeft = bottomLeft
+	b.BottomRight = bottomRight
+	return b
+}
+
+// WithEdges returns a copy of the box with the edge characters replaced.
+// The horizontal character is used for the top and bottom edges, and the
+// vertical character for the left and right edges and column separators.
+func (b Box) WithEdges(horizontal, vertical string) Box {
+	b.Top = horizontal
+	b.Bottom = horizontal
+	b.Left = vertical
+	b.Right = vertical
+	return b
+}
+
+// WithJunctions returns a copy of the box with the junction characters replaced:
+// the T-junctions where column separators meet the top and bottom edges, the
+// T-junctions where horizontal separators meet the left and right edges, and
+// the cross where separators meet inside the table.
+func (b Box) WithJunctions(top, bottom, left, right, cross string) Box {
+	b.MidTop = top
+	b.MidBottom = bottom
+	b.MidLeft = left
+	b.MidRight = right
+	b.Mid = cross
+	return b
+}
+
+// WithColumnSeparator returns a copy of the box with the vertical line drawn
+// between columns replaced. Pass "" to use the Left character again.
+func (b Box) WithColumnSeparator(separator string) Box {
+	b.ColumnSeparator = separator
+	return b
+}
+
+// WithHeaderSeparator returns a copy of the box with the header separator
+// characters replaced: the repeated line character and its left and right ends.
+func (b Box) WithHeaderSeparator(row, left, right string) Box {
+	b.HeaderRow = row
+	b.HeaderLeft = left
+	b.HeaderRight = right
+	return b
+}
+
+// Validate reports an error naming every empty character in the box.
+// ColumnSeparator is optional and never reported.
+// BoxNone (every character empty) is valid, since it intentionally draws no borders.
+//
+// Example:
+//
+//	box := table.Box{TopLeft: "+", Top: "-", TopRight: "+"}
+//	err := box.Validate()
+//	// err: "table: box is missing Left, Right, BottomLeft, ..."
+func (b Box) Validate() error {
+	if b == BoxNone {
+		return nil
+	}
+
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"TopLeft", b.TopLeft},
+		{"Top", b.Top},
+		{"TopRight", b.TopRight},
+		{"Left", b.Left},
+		{"Right", b.Right},
+		{"BottomLeft", b.BottomLeft},
+		{"Bottom", b.Bottom},
+		{"BottomRight", b.BottomRight},
+		{"MidLeft", b.MidLeft},
+		{"MidRight", b.MidRight},
+		{"MidTop", b.MidTop},
+		{"MidBottom", b.MidBottom},
+		{"Mid", b.Mid},
+		{"HeaderRow", b.HeaderRow},
+		{"HeaderLeft", b.HeaderLeft},
+		{"HeaderRight", b.HeaderRight},
+	}
+
+	var missing []string
+	for _, f := range fields {
+		if f.value == "" {
+			missing = append(missing, f.name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("table: box is missing %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
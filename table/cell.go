@@ -0,0 +1,19 @@
+package table
+
+import "github.com/eberle1080/go-rich"
+
+// Cell is a data cell with its own style, used with Table.StyledRow.
+// The cell's style is layered on top of the column's CellStyle: colors set on
+// the cell replace the column's, and attributes from both apply. A cell with
+// a zero Style looks exactly like a plain Row cell.
+//
+// Example:
+//
+//	tbl.StyledRow(
+//		table.Cell{Text: "deploy"},
+//		table.Cell{Text: "FAILED", Style: rich.NewStyle().Bold().Foreground(rich.Red)},
+//	)
+type Cell struct {
+	Text  string     // Text displayed in the cell
+	Style rich.Style // Style layered over the column's CellStyle
+}
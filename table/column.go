@@ -21,6 +21,35 @@ const (
 	AlignRight
 )
 
+// mirror returns the alignment as seen from the opposite side.
+// Used for right-to-left columns, where the origin is the right edge.
+func (a Align) mirror() Align {
+	switch a {
+	case AlignLeft:
+		return AlignRight
+	case AlignRight:
+		return AlignLeft
+	default:
+		return a
+	}
+}
+
+// Direction specifies the text direction of a column.
+type Direction int
+
+const (
+	// DirectionAuto follows the console's default direction (see rich.Console.SetRTL).
+	// This is the default.
+	DirectionAuto Direction = iota
+
+	// DirectionLTR lays out content left-to-right regardless of the console default.
+	DirectionLTR
+
+	// DirectionRTL lays out content right-to-left: alignment is measured from
+	// the right edge, so left-aligned content is padded on the left.
+	DirectionRTL
+)
+
 // Column represents a table column configuration.
 // A column defines how a particular column in a table should be rendered,
 // including its header text, width constraints, alignment, and styling.
@@ -40,7 +69,8 @@ type Column struct {
 	MinWidth int // Minimum width in characters (0 = no minimum)
 	MaxWidth int // Maximum width in characters (0 = unlimited)
 
-	Align Align // How content is aligned within the column
+	Align     Align     // How content is aligned within the column
+	Direction Direction // Text direction (auto follows the console default)
 
 	HeaderStyle rich.Style // Style applied to the header cell
 	CellStyle   rich.Style // Style applied to data cells in this column
@@ -116,6 +146,38 @@ func (c *Column) WithAlign(align Align) *Column {
 	return c
 }
 
+// WithDirection sets the text direction for the column.
+// Right-to-left columns treat the right edge as the origin for alignment,
+// so AlignLeft content is padded on the left and AlignRight on the right.
+// By default, columns follow the console's direction (see rich.Console.SetRTL).
+//
+// Note: This only affects padding; characters are displayed in logical order.
+//
+// Example:
+//
+//	col := table.NewColumn("שם").WithDirection(true)
+func (c *Column) WithDirection(rtl bool) *Column {
+	if rtl {
+		c.Direction = DirectionRTL
+	} else {
+		c.Direction = DirectionLTR
+	}
+	return c
+}
+
+// effectiveAlign returns the alignment to use when rendering, taking the
+// column's direction (or the console default) into account.
+func (c *Column) effectiveAlign(console *rich.Console) Align {
+	rtl := c.Direction == DirectionRTL
+	if c.Direction == DirectionAuto && console != nil {
+		rtl = console.IsRTL()
+	}
+	if rtl {
+		return c.Align.mirror()
+	}
+	return c.Align
+}
+
 // WithHeaderStyle sets the header style for the column.
 // This style is applied only to the header cell in this column.
 //
@@ -46,6 +46,8 @@
 //	tbl.Box(table.BoxDouble)    // Double-line borders
 //	tbl.Box(table.BoxHeavy)     // Heavy borders
 //	tbl.Box(table.BoxSimple)    // Simple single-line borders
+//	tbl.Box(table.BoxDashed)    // Light dashed lines
+//	tbl.Box(table.BoxThickEdge) // Heavy outer edge, light inner lines
 //	tbl.Box(table.BoxASCII)     // ASCII-only characters
 //	tbl.Box(table.BoxNone)      // No borders
 //
@@ -65,6 +67,12 @@
 //		WithHeaderStyle(rich.NewStyle().Bold().Foreground(rich.Yellow)).
 //		WithCellStyle(rich.NewStyle().Italic())
 //
+// Row styling based on the row's data:
+//
+//	tbl.StyleFunc(func(rowIndex int, cells []string) (rich.Style, bool) {
+//		return rich.NewStyle().Foreground(rich.Red), cells[1] == "ERROR"
+//	})
+//
 // # Alignment
 //
 // Control how content is aligned within columns:
@@ -85,6 +93,10 @@
 //   - If Width is set, the column uses that exact width
 //   - Otherwise, width is calculated from content and constrained by MinWidth/MaxWidth
 //   - By default, columns auto-size to fit their content
+//   - If the content is wider than the available width, columns shrink toward
+//     the width of their longest word (narrow columns keep their full width)
+//     and cells are truncated
+//   - With Expand(true), the table widens its columns to fill the available width
 //
 // # Options
 //
@@ -93,6 +105,7 @@
 //	tbl.ShowHeader(false)   // Hide the header row
 //	tbl.ShowEdge(false)     // Hide outer borders
 //	tbl.Padding(2)          // Set cell padding (default: 1)
+//	tbl.ReverseColumns(true) // Render the columns last to first
 //
 // # Custom Box Styles
 //
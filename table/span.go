@@ -0,0 +1,98 @@
+package table
+
+import "github.com/eberle1080/go-rich"
+
+// SpanCell is a cell that covers several adjacent columns.
+// Span cells are used for grouped headers (see Table.SuperHeaders), where a
+// single label sits above a group of related columns.
+//
+// Example:
+//
+//	tbl := table.New().
+//		SuperHeaders([]table.SpanCell{
+//			table.NewSpanCell("Person", 2),
+//			table.NewSpanCell("Address", 2),
+//		}).
+//		Headers("First", "Last", "City", "Country")
+type SpanCell struct {
+	Text  string     // Text displayed in the cell
+	Span  int        // Number of columns covered (values below 1 are treated as 1)
+	Align Align      // How the text is aligned across the spanned width
+	Style rich.Style // Style applied to the text
+}
+
+// NewSpanCell creates a span cell covering the given number of columns.
+// The cell is centered and bold by default, matching typical group headers.
+//
+// Example:
+//
+//	cell := table.NewSpanCell("Q1 Results", 3)
+func NewSpanCell(text string, span int) SpanCell {
+	return SpanCell{
+		Text:  text,
+		Span:  span,
+		Align: AlignCenter,
+		Style: rich.NewStyle().Bold(),
+	}
+}
+
+// layoutSpans fits span cells to the given number of columns.
+// Spans below 1 are widened to 1, spans that run past the last column are
+// clipped, and any columns left uncovered get empty single-column cells.
+func layoutSpans(spans []SpanCell, columnCount int) []SpanCell {
+	var result []SpanCell
+	covered := 0
+
+	for _, cell := range spans {
+		if covered >= columnCount {
+			break
+		}
+		if cell.Span < 1 {
+			cell.Span = 1
+		}
+		if covered+cell.Span > columnCount {
+			cell.Span = columnCount - covered
+		}
+		result = append(result, cell)
+		covered += cell.Span
+	}
+
+	for ; covered < columnCount; covered++ {
+		result = append(result, SpanCell{Span: 1})
+	}
+
+	return result
+}
+
+// spanWidth returns the inner width of a span starting at column start:
+// the spanned column widths plus the padding and separators between them.
+func (t *Table) spanWidth(widths []int, start, span int) int {
+	width := 0
+	for i := start; i < start+span; i++ {
+		width += widths[i]
+		if i > start {
+			width += t.horizontalPadding() + 1 // Padding around and separator between columns
+		}
+	}
+	return width
+}
+
+// spanBoundaries reports, for each gap between adjacent columns, whether a
+// super-header span ends there. Gaps inside a span have no vertical separator
+// in the super-header row.
+func (t *Table) spanBoundaries() []bool {
+	if len(t.columns) == 0 {
+		return nil
+	}
+
+	boundaries := make([]bool, len(t.columns)-1)
+	start := 0
+	for _, cell := range layoutSpans(t.superHeaders, len(t.columns)) {
+		end := start + cell.Span - 1
+		if end < len(boundaries) {
+			boundaries[end] = true
+		}
+		start += cell.Span
+	}
+	return boundaries
+}
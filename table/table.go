@@ -1,6 +1,8 @@
 package table
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/eberle1080/go-rich"
@@ -13,7 +15,7 @@ import (
 //
 // A table consists of:
 //   - Columns: Define headers, widths, alignment, and styles
-//   - Rows: Data organized as string arrays (one per row)
+//   - Rows: Data cells, one per column (plain strings or styled Cells)
 //   - Optional title: Displayed above the table
 //   - Border style (Box): Visual appearance of table borders
 //   - Various display options: header visibility, edge visibility, padding
@@ -26,19 +28,36 @@ import (
 //		Row("Alice", "30").
 //		Row("Bob", "25")
 type Table struct {
-	columns []*Column  // Column configurations (headers, styles, widths)
-	rows    [][]string // Data rows (each row is array of cell values)
+	columns []*Column // Column configurations (headers, styles, widths)
+	rows    [][]Cell  // Data rows (each row is array of cells)
 
-	title string // Optional title displayed at top
-	box   Box    // Border characters to use
+	superHeaders []SpanCell // Grouped headers rendered above the column headers
 
-	showHeader bool // Whether to display the header row
-	showEdge   bool // Whether to display outer borders
+	emptyText  string // Placeholder for empty or missing cells ("" = leave blank)
+	noRowsText string // Placeholder row for a table with no rows ("" = none)
 
-	padding int // Cell padding (spaces on left/right of content)
+	title   string // Optional title displayed at top
+	caption string // Optional caption displayed below the bottom border
+	box     Box    // Border characters to use
 
-	borderStyle rich.Style // Style applied to border characters
-	titleStyle  rich.Style // Style applied to the title
+	showHeader   bool // Whether to display the header row
+	showEdge     bool // Whether to display outer borders
+	showRowLines bool // Whether to draw separators between data rows
+	rowNumbers   bool // Whether to prepend a "#" column numbering the rows
+	reverse      bool // Whether to render the columns in reverse order
+	expand       bool // Whether to widen columns to fill the available width
+
+	padTop    int // Blank lines above each row's content
+	padRight  int // Spaces to the right of cell content
+	padBottom int // Blank lines below each row's content
+	padLeft   int // Spaces to the left of cell content
+
+	borderStyle      rich.Style  // Style applied to border characters
+	innerBorderStyle *rich.Style // Style for column separators (nil = use borderStyle)
+	titleStyle       rich.Style  // Style applied to the title
+	captionStyle     rich.Style  // Style applied to the caption
+
+	styleFunc func(rowIndex int, cells []string) (rich.Style, bool) // Optional per-row style chosen from the row's data
 }
 
 // New creates a new table with sensible defaults.
@@ -61,12 +80,14 @@ type Table struct {
 //	console.Renderln(tbl)
 func New() *Table {
 	return &Table{
-		box:         BoxSimple,
-		showHeader:  true,
-		showEdge:    true,
-		padding:     1,
-		borderStyle: rich.NewStyle().Dim(),
-		titleStyle:  rich.NewStyle().Bold(),
+		box:          BoxSimple,
+		showHeader:   true,
+		showEdge:     true,
+		padLeft:      1,
+		padRight:     1,
+		borderStyle:  rich.NewStyle().Dim(),
+		titleStyle:   rich.NewStyle().Bold(),
+		captionStyle: rich.NewStyle().Dim().Italic(),
 	}
 }
 
@@ -82,6 +103,21 @@ func (t *Table) Title(title string) *Table {
 	return t
 }
 
+// Caption sets a caption displayed below the table.
+// The caption is centered under the bottom border, across the full width of
+// the table, and truncated if it is wider. It is useful for footnotes and
+// pagination hints. If empty (default), no caption is shown.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Name", "Email").
+//		Caption("Showing 10 of 200 rows")
+func (t *Table) Caption(caption string) *Table {
+	t.caption = caption
+	return t
+}
+
 // Box sets the border style using predefined or custom box characters.
 // See the Box type and predefined styles (BoxSimple, BoxRounded, etc.) for options.
 //
@@ -117,18 +153,183 @@ func (t *Table) ShowEdge(show bool) *Table {
 	return t
 }
 
+// ShowRowLines controls whether to draw a separator line between data rows.
+// Row lines use the box's HeaderRow character with MidLeft and MidRight at
+// the outer ends and Mid where they cross column separators. When edges are
+// hidden (ShowEdge(false)), the outer junctions are omitted so lines end
+// flush with the cells instead of leaving dangling T-junctions.
+// Default is false.
+//
+// Example:
+//
+//	tbl := table.New().ShowRowLines(true)
+func (t *Table) ShowRowLines(show bool) *Table {
+	t.showRowLines = show
+	return t
+}
+
+// ShowRowNumbers sets whether to prepend a right-aligned "#" column that
+// numbers the data rows from 1. The numbers are generated at render time, so
+// they follow the current row order (e.g. after SortBy) and don't need to be
+// supplied with Row. The column is sized and aligned like any other.
+// Default is false.
+//
+// Example:
+//
+//	tbl := table.New().Headers("Task").ShowRowNumbers(true)
+//	// │ # │ Task    │
+//	// │ 1 │ Compile │
+func (t *Table) ShowRowNumbers(show bool) *Table {
+	t.rowNumbers = show
+	return t
+}
+
+// withRowNumbers returns a copy of the table with the "#" column prepended
+// to the columns, rows, and super headers. The original table is unchanged.
+func (t *Table) withRowNumbers() *Table {
+	numbered := *t
+	numbered.rowNumbers = false
+
+	numbered.columns = append([]*Column{NewColumn("#").WithAlign(AlignRight)}, t.columns...)
+
+	numbered.rows = make([][]Cell, len(t.rows))
+	for i, row := range t.rows {
+		numbered.rows[i] = append([]Cell{{Text: strconv.Itoa(i + 1)}}, row...)
+	}
+
+	if len(t.superHeaders) > 0 {
+		numbered.superHeaders = append([]SpanCell{{Span: 1}}, t.superHeaders...)
+	}
+
+	// StyleFunc sees the row's own cells, without the number
+	if t.styleFunc != nil {
+		numbered.styleFunc = func(rowIndex int, cells []string) (rich.Style, bool) {
+			return t.styleFunc(rowIndex, cells[1:])
+		}
+	}
+
+	return &numbered
+}
+
+// ReverseColumns sets whether the columns are rendered in reverse order,
+// last column first. Only the rendering changes: rows are still added, sorted
+// (SortBy), and passed to StyleFunc in the original column order, so the same
+// data can be shown "name-first" or "value-first" by flipping one option.
+// Super headers are reversed along with the columns they span, and the "#"
+// column of ShowRowNumbers stays on the left. Default is false.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Name", "Value").
+//		Row("cpu", "93%").
+//		ReverseColumns(true)
+//	// │ Value │ Name │
+//	// │ 93%   │ cpu  │
+func (t *Table) ReverseColumns(reverse bool) *Table {
+	t.reverse = reverse
+	return t
+}
+
+// withReversedColumns returns a copy of the table with the columns, row
+// cells, and super headers in reverse order. The original table is unchanged.
+func (t *Table) withReversedColumns() *Table {
+	reversed := *t
+	reversed.reverse = false
+
+	n := len(t.columns)
+	reversed.columns = make([]*Column, n)
+	for i, col := range t.columns {
+		reversed.columns[n-1-i] = col
+	}
+
+	// Rows are padded to the column count first so that missing cells
+	// stay missing instead of shifting the rest of the row over
+	reversed.rows = make([][]Cell, len(t.rows))
+	for i, row := range t.rows {
+		cells := make([]Cell, n)
+		copy(cells, row)
+		for j := 0; j < n/2; j++ {
+			cells[j], cells[n-1-j] = cells[n-1-j], cells[j]
+		}
+		reversed.rows[i] = cells
+	}
+
+	if len(t.superHeaders) > 0 {
+		spans := layoutSpans(t.superHeaders, n)
+		reversed.superHeaders = make([]SpanCell, len(spans))
+		for i, span := range spans {
+			reversed.superHeaders[len(spans)-1-i] = span
+		}
+	}
+
+	// StyleFunc sees the cells in their original order
+	if t.styleFunc != nil {
+		reversed.styleFunc = func(rowIndex int, cells []string) (rich.Style, bool) {
+			original := make([]string, len(cells))
+			for i, cell := range cells {
+				original[len(cells)-1-i] = cell
+			}
+			return t.styleFunc(rowIndex, original)
+		}
+	}
+
+	return &reversed
+}
+
+// Expand sets whether the table fills the available width.
+// By default a table is only as wide as its content needs (and shrinks its
+// columns if that is wider than the available width). When expanding, the
+// extra space is spread over the columns that have neither a fixed Width nor
+// a MaxWidth, in proportion to their natural widths.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Service", "Status").
+//		Expand(true) // Span the whole terminal
+func (t *Table) Expand(expand bool) *Table {
+	t.expand = expand
+	return t
+}
+
 // Padding sets the cell padding in characters.
-// Padding is added to both left and right sides of cell content.
+// Padding is added to both left and right sides of cell content; it is a
+// shortcut for PaddingSides(0, padding, 0, padding).
 // Default is 1.
 //
 // Example:
 //
 //	tbl := table.New().Padding(2) // 2 spaces on each side
 func (t *Table) Padding(padding int) *Table {
-	t.padding = padding
+	return t.PaddingSides(0, padding, 0, padding)
+}
+
+// PaddingSides sets the cell padding for each side individually, in CSS
+// order. Left and right padding are spaces around each cell's content;
+// top and bottom padding are blank lines added above and below the content
+// of the header and every data row. Negative values are treated as 0.
+//
+// Example:
+//
+//	// Roomy horizontal spacing without extra blank lines
+//	tbl := table.New().PaddingSides(0, 3, 0, 3)
+//
+//	// One blank line above and below each row
+//	tbl := table.New().PaddingSides(1, 1, 1, 1)
+func (t *Table) PaddingSides(top, right, bottom, left int) *Table {
+	t.padTop = max(top, 0)
+	t.padRight = max(right, 0)
+	t.padBottom = max(bottom, 0)
+	t.padLeft = max(left, 0)
 	return t
 }
 
+// horizontalPadding returns the total left and right padding of a cell.
+func (t *Table) horizontalPadding() int {
+	return t.padLeft + t.padRight
+}
+
 // BorderStyle sets the style for all border characters.
 // This affects the visual appearance of the borders but not their shape.
 // Default is dim (faint) style.
@@ -141,6 +342,43 @@ func (t *Table) BorderStyle(style rich.Style) *Table {
 	return t
 }
 
+// InnerBorderStyle sets the style for the vertical separators between columns
+// and the junctions where they meet horizontal lines. BorderStyle continues to
+// govern the outer edge and horizontal lines.
+// By default, inner separators use the BorderStyle.
+//
+// Example:
+//
+//	tbl := table.New().
+//		BorderStyle(rich.NewStyle().Foreground(rich.Blue)).
+//		InnerBorderStyle(rich.NewStyle().Dim())
+func (t *Table) InnerBorderStyle(style rich.Style) *Table {
+	t.innerBorderStyle = &style
+	return t
+}
+
+// columnSeparator returns the vertical separator drawn between columns:
+// the box's ColumnSeparator, or its Left character if that is unset.
+// Boxes without a vertical character (such as BoxNone) get a single space
+// so that adjacent columns never run together.
+func (t *Table) columnSeparator() string {
+	if t.box.ColumnSeparator != "" {
+		return t.box.ColumnSeparator
+	}
+	if t.box.Left == "" {
+		return " "
+	}
+	return t.box.Left
+}
+
+// innerBorderStyleOrDefault returns the style for inner column separators.
+func (t *Table) innerBorderStyleOrDefault() rich.Style {
+	if t.innerBorderStyle != nil {
+		return *t.innerBorderStyle
+	}
+	return t.borderStyle
+}
+
 // TitleStyle sets the style for the title text.
 // Only affects the title if one is set via Title().
 // Default is bold style.
@@ -155,6 +393,20 @@ func (t *Table) TitleStyle(style rich.Style) *Table {
 	return t
 }
 
+// CaptionStyle sets the style for the caption text.
+// Only affects the caption if one is set via Caption().
+// Default is dim italic style.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Caption("Prices include tax").
+//		CaptionStyle(rich.NewStyle().Foreground(rich.Yellow))
+func (t *Table) CaptionStyle(style rich.Style) *Table {
+	t.captionStyle = style
+	return t
+}
+
 // AddColumn adds a column to the table.
 // Use this when you need fine-grained control over column configuration.
 // For simple cases, use Headers() instead.
@@ -184,6 +436,56 @@ func (t *Table) Headers(headers ...string) *Table {
 	return t
 }
 
+// SuperHeaders sets a row of grouped headers rendered above the column headers.
+// Each span cell covers one or more adjacent columns, from left to right.
+// Columns not covered by any span get an empty cell.
+// The super-header row is hidden along with the header when ShowHeader(false) is set.
+//
+// Example:
+//
+//	tbl := table.New().
+//		SuperHeaders([]table.SpanCell{
+//			table.NewSpanCell("Person", 2),
+//			table.NewSpanCell("Address", 2),
+//		}).
+//		Headers("First", "Last", "City", "Country")
+func (t *Table) SuperHeaders(spans []SpanCell) *Table {
+	t.superHeaders = spans
+	return t
+}
+
+// EmptyText sets a placeholder displayed in dim style for cells that are empty
+// or missing (when a row has fewer cells than there are columns).
+// By default, such cells are left blank.
+// For a message shown when the table has no rows at all, see NoRowsText.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Name", "Email").
+//		EmptyText("—").
+//		Row("Alice") // Email shows a dim "—"
+func (t *Table) EmptyText(s string) *Table {
+	t.emptyText = s
+	return t
+}
+
+// NoRowsText sets a message shown when the table has no rows: instead of
+// just the header and borders, a single row spanning all columns is rendered
+// with the message centered in it, in dim style. Text wider than the table is
+// truncated. By default, a table without rows shows no such row.
+//
+// Example:
+//
+//	// A dashboard table that may have nothing to show
+//	alerts := table.New().
+//		Headers("Time", "Severity", "Message").
+//		NoRowsText("No data")
+func (t *Table) NoRowsText(s string) *Table {
+	t.noRowsText = s
+	return t
+}
+
 // Row adds a data row to the table.
 // Each argument becomes a cell in the row, matched to columns by position.
 // If fewer cells than columns are provided, remaining cells are empty.
@@ -196,10 +498,135 @@ func (t *Table) Headers(headers ...string) *Table {
 //		Row("Alice", "30").
 //		Row("Bob", "25")
 func (t *Table) Row(cells ...string) *Table {
+	row := make([]Cell, len(cells))
+	for i, text := range cells {
+		row[i] = Cell{Text: text}
+	}
+	t.rows = append(t.rows, row)
+	return t
+}
+
+// StyledRow adds a data row whose cells can each have their own style.
+// A cell's style is layered on top of its column's CellStyle (see Cell), so
+// only the cells that need emphasis have to set one. Otherwise StyledRow
+// behaves like Row.
+//
+// Example:
+//
+//	red := rich.NewStyle().Bold().Foreground(rich.Red)
+//	tbl := table.New().
+//		Headers("Job", "Status").
+//		Row("build", "ok").
+//		StyledRow(table.Cell{Text: "deploy"}, table.Cell{Text: "FAILED", Style: red})
+func (t *Table) StyledRow(cells ...Cell) *Table {
 	t.rows = append(t.rows, cells)
 	return t
 }
 
+// StyleFunc sets a function that chooses a style for each data row from its
+// contents, such as coloring failed jobs red. It is called at render time
+// with the row's index (in the current order, starting at 0) and the text of
+// its cells, one per column with "" for missing cells. When it returns true,
+// the style is layered over the column styles of every cell in the row, and a
+// cell's own style (see StyledRow) is layered over that in turn.
+// Pass nil to remove the function.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Job", "Status").
+//		Row("build", "OK").
+//		Row("deploy", "ERROR").
+//		StyleFunc(func(rowIndex int, cells []string) (rich.Style, bool) {
+//			if cells[1] == "ERROR" {
+//				return rich.NewStyle().Foreground(rich.Red), true
+//			}
+//			return rich.Style{}, false
+//		})
+func (t *Table) StyleFunc(fn func(rowIndex int, cells []string) (rich.Style, bool)) *Table {
+	t.styleFunc = fn
+	return t
+}
+
+// rowStyle returns the style StyleFunc chooses for the row at index i, and
+// whether it chose one.
+func (t *Table) rowStyle(i int, row []Cell) (rich.Style, bool) {
+	if t.styleFunc == nil {
+		return rich.Style{}, false
+	}
+
+	cells := make([]string, len(t.columns))
+	for j := range cells {
+		if j < len(row) {
+			cells[j] = row[j].Text
+		}
+	}
+	return t.styleFunc(i, cells)
+}
+
+// SortBy reorders the rows added so far by the text of one column, using
+// less to compare cell values. The sort is stable, so rows with equal values
+// keep the order they were added in. Missing cells compare as "". An index
+// outside the columns leaves the rows unchanged.
+//
+// Rows added after sorting are appended at the end; sort again once all rows
+// are in.
+//
+// Example:
+//
+//	// Sort by name, case-insensitively
+//	tbl.SortBy(0, func(a, b string) bool {
+//		return strings.ToLower(a) < strings.ToLower(b)
+//	})
+func (t *Table) SortBy(columnIndex int, less func(a, b string) bool) *Table {
+	if columnIndex < 0 || columnIndex >= len(t.columns) {
+		return t
+	}
+
+	value := func(row []Cell) string {
+		if columnIndex < len(row) {
+			return row[columnIndex].Text
+		}
+		return ""
+	}
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		return less(value(t.rows[i]), value(t.rows[j]))
+	})
+	return t
+}
+
+// SortByColumn sorts the rows in ascending order of one column (see SortBy).
+// In numeric mode, cell values are parsed as numbers (surrounding spaces are
+// ignored), so "9" sorts before "10"; values that aren't numbers sort after
+// all numbers, in lexical order. Otherwise values are compared as strings.
+//
+// Example:
+//
+//	tbl := table.New().
+//		Headers("Service", "Requests").
+//		Row("api", "1200").
+//		Row("web", "800").
+//		SortByColumn(1, true) // web, api
+func (t *Table) SortByColumn(columnIndex int, numeric bool) *Table {
+	if !numeric {
+		return t.SortBy(columnIndex, func(a, b string) bool { return a < b })
+	}
+
+	return t.SortBy(columnIndex, func(a, b string) bool {
+		x, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+		y, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+		switch {
+		case errA == nil && errB == nil:
+			return x < y
+		case errA == nil || errB == nil:
+			// Numbers come before everything else
+			return errA == nil
+		default:
+			return a < b
+		}
+	})
+}
+
 // Render implements rich.Renderable.
 // Converts the table into styled segments that can be displayed on the console.
 //
@@ -207,108 +634,299 @@ func (t *Table) Row(cells ...string) *Table {
 //  1. Calculate optimal column widths based on content and constraints
 //  2. Render top border (if showEdge is true)
 //  3. Render title row (if title is set)
-//  4. Render header row (if showHeader is true)
-//  5. Render header separator
-//  6. Render data rows
-//  7. Render bottom border (if showEdge is true)
+//  4. Render super-header row and separator (if super headers are set)
+//  5. Render header row (if showHeader is true)
+//  6. Render header separator
+//  7. Render data rows (with row lines between them, if enabled,
+//     or the NoRowsText placeholder row if there are none)
+//  8. Render bottom border (if showEdge is true)
+//  9. Render caption line (if caption is set)
 //
 // The width parameter is the maximum available width for the table.
 // The console parameter provides access to the color mode and other settings.
 func (t *Table) Render(console *rich.Console, width int) rich.Segments {
+	var segments rich.Segments
+	t.RenderStream(console, width, func(chunk rich.Segments) {
+		segments = append(segments, chunk...)
+	})
+	return segments
+}
+
+// RenderStream implements rich.StreamRenderable.
+// Produces the same output as Render, but emits it one line at a time:
+// every line after the first is a separate chunk starting with a newline
+// segment. Each data row is therefore emitted as soon as it is rendered,
+// which keeps memory flat for very large tables.
+//
+// Column widths are still calculated from all rows up front, so the rows
+// themselves must already be in memory.
+func (t *Table) RenderStream(console *rich.Console, width int, emit func(rich.Segments)) {
+	if t.reverse {
+		t = t.withReversedColumns()
+	}
+	if t.rowNumbers {
+		t = t.withRowNumbers()
+	}
+
 	// Empty table with no columns
 	if len(t.columns) == 0 {
-		return nil
+		return
 	}
 
 	// Calculate optimal widths for each column
 	widths := t.calculateWidths(width)
 
-	var segments rich.Segments
+	// Horizontal lines drawn with empty characters (e.g. BoxNone) are skipped
+	// entirely rather than leaving blank lines
+	first := true
+	emitLine := func(line rich.Segments) {
+		if !first {
+			line = append(rich.Segments{{Text: "\n"}}, line...)
+		}
+		first = false
+		emit(line)
+	}
 
 	// Render top border
-	if t.showEdge {
-		segments = append(segments, t.renderTopBorder(widths)...)
-		segments = append(segments, rich.Segment{Text: "\n"})
+	if t.showEdge && t.box.Top != "" {
+		emitLine(t.renderTopBorder(widths))
 	}
 
 	// Render title if present
 	if t.title != "" {
-		segments = append(segments, t.renderTitle(widths)...)
-		segments = append(segments, rich.Segment{Text: "\n"})
+		emitLine(t.renderTitle(widths))
+	}
+
+	// Render super-header (grouped headers)
+	if t.showHeader && len(t.superHeaders) > 0 {
+		emitLine(t.renderSuperHeader(widths))
+		if t.box.HeaderRow != "" {
+			emitLine(t.renderSuperHeaderSeparator(widths))
+		}
 	}
 
 	// Render header
 	if t.showHeader {
-		segments = append(segments, t.renderHeader(widths)...)
-		segments = append(segments, rich.Segment{Text: "\n"})
+		t.emitPadded(widths, emitLine, t.renderHeader(console, widths))
 
 		// Header separator
-		segments = append(segments, t.renderHeaderSeparator(widths)...)
-		segments = append(segments, rich.Segment{Text: "\n"})
+		if t.box.HeaderRow != "" {
+			emitLine(t.renderHeaderSeparator(widths))
+		}
 	}
 
 	// Render rows
 	for i, row := range t.rows {
-		segments = append(segments, t.renderRow(row, widths)...)
-		if i < len(t.rows)-1 {
-			segments = append(segments, rich.Segment{Text: "\n"})
+		if i > 0 && t.showRowLines && t.box.HeaderRow != "" {
+			emitLine(t.renderRowSeparator(widths))
 		}
+		t.emitPadded(widths, emitLine, t.renderRow(console, i, row, widths))
 	}
 
-	// Render bottom border
-	if len(t.rows) > 0 {
-		segments = append(segments, rich.Segment{Text: "\n"})
+	// Placeholder row for a table without data
+	if len(t.rows) == 0 && t.noRowsText != "" {
+		t.emitPadded(widths, emitLine, t.renderEmptyRow(widths))
 	}
-	if t.showEdge {
-		segments = append(segments, t.renderBottomBorder(widths)...)
+
+	// Render bottom border
+	if t.showEdge && t.box.Bottom != "" {
+		emitLine(t.renderBottomBorder(widths))
 	}
 
-	return segments
+	// Render caption if present
+	if t.caption != "" {
+		emitLine(t.renderCaption(widths))
+	}
 }
 
-// calculateWidths determines the optimal width for each column.
-// The algorithm:
-//  1. Start with the maximum of header length and MinWidth for each column
-//  2. Expand widths to fit the longest content in each column
-//  3. Apply Width (fixed) or MaxWidth (ceiling) constraints
+// Measure implements rich.Measurable.
+// Reports the table's total width range, including borders and padding:
+//   - Minimum: each column at the width of its longest word (or MinWidth)
+//   - Maximum: each column at the width of its longest cell (or MaxWidth)
 //
-// This ensures:
-//   - Headers are fully visible (unless overridden by Width/MaxWidth)
-//   - Content fits when possible (within MaxWidth constraints)
-//   - MinWidth constraints are respected
-//   - Fixed-width columns (Width > 0) override calculated widths
+// Fixed-width columns (Width > 0) contribute their fixed width to both.
+// An expanding table (see Expand) reports at least maxWidth as its Maximum.
+// Use this to predict the rendered width before rendering, or to let a
+// table participate in layouts that size content to fit.
 //
-// Note: The totalWidth parameter is currently unused. Future implementations
-// may use it to proportionally shrink columns when total width exceeds available space.
-func (t *Table) calculateWidths(totalWidth int) []int {
-	widths := make([]int, len(t.columns))
+// Example:
+//
+//	m := tbl.Measure(console, console.Width())
+//	if m.Minimum > console.Width() {
+//		// The table can't fit without truncation
+//	}
+func (t *Table) Measure(console *rich.Console, maxWidth int) rich.Measurement {
+	if t.rowNumbers {
+		t = t.withRowNumbers()
+	}
+	if len(t.columns) == 0 {
+		return rich.Measurement{}
+	}
+
+	minimum, maximum := t.structureWidth(), t.structureWidth()
+	for _, m := range t.columnMeasurements() {
+		minimum += m.Minimum
+		maximum += m.Maximum
+	}
+
+	// An expanding table uses all the width it is given
+	if t.expand && maximum < maxWidth {
+		maximum = maxWidth
+	}
+
+	return rich.Measurement{
+		Minimum: minimum,
+		Maximum: maximum,
+	}
+}
+
+// longestWord returns the display width of the longest space-separated word in s.
+func longestWord(s string) int {
+	longest := 0
+	for _, word := range strings.Fields(s) {
+		if w := rich.DisplayWidth(word); w > longest {
+			longest = w
+		}
+	}
+	return longest
+}
+
+// columnMeasurements returns the width range of each column's content:
+//   - Minimum: the longest word in the header or any cell (or MinWidth)
+//   - Maximum: the longest header or cell (or MinWidth), capped by MaxWidth
+//
+// Fixed-width columns (Width > 0) report their fixed width for both. The
+// last column of a super-header span is widened, if needed, so the span's
+// text fits at the maximum widths.
+func (t *Table) columnMeasurements() []rich.Measurement {
+	measurements := make([]rich.Measurement, len(t.columns))
 
-	// Phase 1: Initialize with maximum of header length and MinWidth
 	for i, col := range t.columns {
-		widths[i] = len(col.Header)
-		if col.MinWidth > widths[i] {
-			widths[i] = col.MinWidth
+		if col.Width > 0 {
+			measurements[i] = rich.Measurement{Minimum: col.Width, Maximum: col.Width}
+			continue
 		}
+
+		minimum := longestWord(col.Header)
+		maximum := rich.DisplayWidth(col.Header)
+		for _, row := range t.rows {
+			text, _ := t.cellText(row, i)
+			if w := longestWord(text); w > minimum {
+				minimum = w
+			}
+			if w := rich.DisplayWidth(text); w > maximum {
+				maximum = w
+			}
+		}
+
+		m := rich.Measurement{Minimum: minimum, Maximum: maximum}.Clamp(col.MinWidth, maximum)
+		if col.MaxWidth > 0 {
+			// MaxWidth is a ceiling for both, even below the longest word
+			m = rich.Measurement{Minimum: min(m.Minimum, col.MaxWidth), Maximum: min(m.Maximum, col.MaxWidth)}
+		}
+		measurements[i] = m
 	}
 
-	// Phase 2: Expand to fit content (longest cell in each column)
-	for _, row := range t.rows {
-		for i := 0; i < len(row) && i < len(widths); i++ {
-			cellLen := len(row[i])
-			if cellLen > widths[i] {
-				widths[i] = cellLen
+	// Make room for super-header text
+	if t.showHeader {
+		maximums := make([]int, len(measurements))
+		for i, m := range measurements {
+			maximums[i] = m.Maximum
+		}
+		start := 0
+		for _, cell := range layoutSpans(t.superHeaders, len(t.columns)) {
+			if extra := rich.DisplayWidth(cell.Text) - t.spanWidth(maximums, start, cell.Span); extra > 0 {
+				measurements[start+cell.Span-1].Maximum += extra
 			}
+			start += cell.Span
 		}
 	}
 
-	// Phase 3: Apply width constraints
-	for i, col := range t.columns {
-		if col.Width > 0 {
-			// Fixed width overrides calculated width
-			widths[i] = col.Width
-		} else if col.MaxWidth > 0 && widths[i] > col.MaxWidth {
-			// MaxWidth provides a ceiling
-			widths[i] = col.MaxWidth
+	return measurements
+}
+
+// structureWidth returns the width taken by everything but cell content:
+// padding, column separators, and outer edges.
+func (t *Table) structureWidth() int {
+	structure := len(t.columns)*t.horizontalPadding() + len(t.columns) - 1
+	if t.showEdge {
+		structure += rich.DisplayWidth(t.box.Left) + rich.DisplayWidth(t.box.Right)
+	}
+	return structure
+}
+
+// calculateWidths determines the width of each column's content so the
+// table fits in totalWidth. The algorithm:
+//  1. Measure each column (see columnMeasurements)
+//  2. Start every column at its minimum width
+//  3. Share the remaining space, visiting columns from the one that needs
+//     the least extra space to the one that needs the most: each is offered
+//     an equal share of what's left and takes what it needs of it, using
+//     Measurement.Get. Columns that need little get their maximum, and the
+//     space they don't use goes to wider columns.
+//  4. If the table expands, spread any space still left over the columns
+//     without Width or MaxWidth, in proportion to their widths
+//
+// If totalWidth is too small even for the minimum widths, the minimum widths
+// are used and the table is wider than totalWidth. A totalWidth of 0 or less
+// means no limit.
+func (t *Table) calculateWidths(totalWidth int) []int {
+	measurements := t.columnMeasurements()
+	widths := make([]int, len(measurements))
+
+	if totalWidth <= 0 {
+		for i, m := range measurements {
+			widths[i] = m.Maximum
+		}
+		return widths
+	}
+
+	// Phase 2: Every column gets at least its minimum
+	remaining := totalWidth - t.structureWidth()
+	for i, m := range measurements {
+		widths[i] = m.Minimum
+		remaining -= m.Minimum
+	}
+	if remaining <= 0 {
+		return widths
+	}
+
+	// Phase 3: Share the rest, least demanding columns first
+	order := make([]int, len(measurements))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		ma, mb := measurements[order[a]], measurements[order[b]]
+		return ma.Maximum-ma.Minimum < mb.Maximum-mb.Minimum
+	})
+	for k, i := range order {
+		m := measurements[i]
+		share := remaining / (len(order) - k)
+		widths[i] = m.Get(m.Minimum + share)
+		remaining -= widths[i] - m.Minimum
+	}
+
+	// Phase 4: Fill the available width
+	if t.expand && remaining > 0 {
+		var flexible []int
+		flexibleWidth := 0
+		for i, col := range t.columns {
+			if col.Width == 0 && col.MaxWidth == 0 {
+				flexible = append(flexible, i)
+				flexibleWidth += widths[i]
+			}
+		}
+		for k, i := range flexible {
+			var extra int
+			if flexibleWidth > 0 {
+				extra = remaining * widths[i] / flexibleWidth
+			} else {
+				extra = remaining / (len(flexible) - k)
+			}
+			flexibleWidth -= widths[i]
+			widths[i] += extra
+			remaining -= extra
 		}
 	}
 
@@ -316,9 +934,19 @@ func (t *Table) calculateWidths(totalWidth int) []int {
 }
 
 // renderTopBorder renders the top border of the table.
+// Junctions are only drawn where a column separator starts directly below:
+// none when a title row follows, and only where a span ends when super
+// headers follow.
 func (t *Table) renderTopBorder(widths []int) rich.Segments {
 	var segments rich.Segments
 
+	var boundaries []bool
+	if t.title != "" {
+		boundaries = make([]bool, len(widths))
+	} else if t.showHeader && len(t.superHeaders) > 0 {
+		boundaries = t.spanBoundaries()
+	}
+
 	if t.showEdge {
 		segments = append(segments, rich.Segment{
 			Text:  t.box.TopLeft,
@@ -327,16 +955,20 @@ func (t *Table) renderTopBorder(widths []int) rich.Segments {
 	}
 
 	for i, width := range widths {
-		padding := strings.Repeat(t.box.Top, width+t.padding*2)
+		padding := strings.Repeat(t.box.Top, width+t.horizontalPadding())
 		segments = append(segments, rich.Segment{
 			Text:  padding,
 			Style: t.borderStyle,
 		})
 
 		if i < len(widths)-1 {
+			junction, style := t.box.MidTop, t.innerBorderStyleOrDefault()
+			if boundaries != nil && !boundaries[i] {
+				junction, style = t.box.Top, t.borderStyle
+			}
 			segments = append(segments, rich.Segment{
-				Text:  t.box.MidTop,
-				Style: t.borderStyle,
+				Text:  junction,
+				Style: style,
 			})
 		}
 	}
@@ -363,7 +995,7 @@ func (t *Table) renderBottomBorder(widths []int) rich.Segments {
 	}
 
 	for i, width := range widths {
-		padding := strings.Repeat(t.box.Bottom, width+t.padding*2)
+		padding := strings.Repeat(t.box.Bottom, width+t.horizontalPadding())
 		segments = append(segments, rich.Segment{
 			Text:  padding,
 			Style: t.borderStyle,
@@ -372,7 +1004,7 @@ func (t *Table) renderBottomBorder(widths []int) rich.Segments {
 		if i < len(widths)-1 {
 			segments = append(segments, rich.Segment{
 				Text:  t.box.MidBottom,
-				Style: t.borderStyle,
+				Style: t.innerBorderStyleOrDefault(),
 			})
 		}
 	}
@@ -399,7 +1031,7 @@ func (t *Table) renderHeaderSeparator(widths []int) rich.Segments {
 	}
 
 	for i, width := range widths {
-		padding := strings.Repeat(t.box.HeaderRow, width+t.padding*2)
+		padding := strings.Repeat(t.box.HeaderRow, width+t.horizontalPadding())
 		segments = append(segments, rich.Segment{
 			Text:  padding,
 			Style: t.borderStyle,
@@ -408,7 +1040,180 @@ func (t *Table) renderHeaderSeparator(widths []int) rich.Segments {
 		if i < len(widths)-1 {
 			segments = append(segments, rich.Segment{
 				Text:  t.box.Mid,
-				Style: t.borderStyle,
+				Style: t.innerBorderStyleOrDefault(),
+			})
+		}
+	}
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.HeaderRight,
+			Style: t.borderStyle,
+		})
+	}
+
+	return segments
+}
+
+// emitPadded emits a content line surrounded by the blank lines that make
+// up the top and bottom cell padding.
+func (t *Table) emitPadded(widths []int, emitLine func(rich.Segments), line rich.Segments) {
+	for i := 0; i < t.padTop; i++ {
+		emitLine(t.renderBlankRow(widths))
+	}
+	emitLine(line)
+	for i := 0; i < t.padBottom; i++ {
+		emitLine(t.renderBlankRow(widths))
+	}
+}
+
+// renderBlankRow renders an empty row with edges and column separators,
+// used for vertical cell padding.
+func (t *Table) renderBlankRow(widths []int) rich.Segments {
+	var segments rich.Segments
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Left,
+			Style: t.borderStyle,
+		})
+	}
+
+	for i, width := range widths {
+		segments = append(segments, rich.Segment{
+			Text: strings.Repeat(" ", width+t.horizontalPadding()),
+		})
+
+		if i < len(widths)-1 {
+			segments = append(segments, rich.Segment{
+				Text:  t.columnSeparator(),
+				Style: t.innerBorderStyleOrDefault(),
+			})
+		}
+	}
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Right,
+			Style: t.borderStyle,
+		})
+	}
+
+	return segments
+}
+
+// renderRowSeparator renders the line drawn between data rows when row
+// lines are enabled. The outer junctions are only drawn alongside the edges.
+func (t *Table) renderRowSeparator(widths []int) rich.Segments {
+	var segments rich.Segments
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.MidLeft,
+			Style: t.borderStyle,
+		})
+	}
+
+	for i, width := range widths {
+		segments = append(segments, rich.Segment{
+			Text:  strings.Repeat(t.box.HeaderRow, width+t.horizontalPadding()),
+			Style: t.borderStyle,
+		})
+
+		if i < len(widths)-1 {
+			segments = append(segments, rich.Segment{
+				Text:  t.box.Mid,
+				Style: t.innerBorderStyleOrDefault(),
+			})
+		}
+	}
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.MidRight,
+			Style: t.borderStyle,
+		})
+	}
+
+	return segments
+}
+
+// renderSuperHeader renders the grouped header row.
+// Each span cell is aligned across the combined width of the columns it covers.
+func (t *Table) renderSuperHeader(widths []int) rich.Segments {
+	var segments rich.Segments
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Left,
+			Style: t.borderStyle,
+		})
+	}
+
+	start := 0
+	for _, cell := range layoutSpans(t.superHeaders, len(t.columns)) {
+		width := t.spanWidth(widths, start, cell.Span)
+
+		text := rich.Truncate(cell.Text, width, "")
+
+		segments = append(segments, rich.Segment{
+			Text: strings.Repeat(" ", t.padLeft),
+		})
+		segments = append(segments, rich.Segment{
+			Text:  t.alignText(text, width, cell.Align),
+			Style: cell.Style,
+		})
+		segments = append(segments, rich.Segment{
+			Text: strings.Repeat(" ", t.padRight),
+		})
+
+		start += cell.Span
+		if start < len(t.columns) {
+			segments = append(segments, rich.Segment{
+				Text:  t.columnSeparator(),
+				Style: t.innerBorderStyleOrDefault(),
+			})
+		}
+	}
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Right,
+			Style: t.borderStyle,
+		})
+	}
+
+	return segments
+}
+
+// renderSuperHeaderSeparator renders the separator between the super-header
+// and the column headers. Gaps inside a span get a top junction, since the
+// column separator starts below this line.
+func (t *Table) renderSuperHeaderSeparator(widths []int) rich.Segments {
+	var segments rich.Segments
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.HeaderLeft,
+			Style: t.borderStyle,
+		})
+	}
+
+	boundaries := t.spanBoundaries()
+	for i, width := range widths {
+		segments = append(segments, rich.Segment{
+			Text:  strings.Repeat(t.box.HeaderRow, width+t.horizontalPadding()),
+			Style: t.borderStyle,
+		})
+
+		if i < len(widths)-1 {
+			junction := t.box.Mid
+			if !boundaries[i] {
+				junction = t.box.MidTop
+			}
+			segments = append(segments, rich.Segment{
+				Text:  junction,
+				Style: t.innerBorderStyleOrDefault(),
 			})
 		}
 	}
@@ -427,7 +1232,7 @@ func (t *Table) renderHeaderSeparator(widths []int) rich.Segments {
 func (t *Table) renderTitle(widths []int) rich.Segments {
 	totalWidth := 0
 	for i, w := range widths {
-		totalWidth += w + t.padding*2
+		totalWidth += w + t.horizontalPadding()
 		if i < len(widths)-1 {
 			totalWidth += 1 // separator
 		}
@@ -442,7 +1247,7 @@ func (t *Table) renderTitle(widths []int) rich.Segments {
 		})
 	}
 
-	titleLen := len(t.title)
+	titleLen := rich.DisplayWidth(t.title)
 	leftPad := (totalWidth - titleLen) / 2
 	rightPad := totalWidth - titleLen - leftPad
 
@@ -469,8 +1274,39 @@ func (t *Table) renderTitle(widths []int) rich.Segments {
 	return segments
 }
 
+// renderCaption renders the caption line below the table.
+// Unlike the title, it sits outside the borders, so it is centered across
+// the table's full width including the edges.
+func (t *Table) renderCaption(widths []int) rich.Segments {
+	totalWidth := t.spanWidth(widths, 0, len(widths)) + t.horizontalPadding()
+	if t.showEdge {
+		totalWidth += rich.DisplayWidth(t.box.Left) + rich.DisplayWidth(t.box.Right)
+	}
+
+	caption := rich.Truncate(t.caption, totalWidth, "")
+	captionLen := rich.DisplayWidth(caption)
+	leftPad := (totalWidth - captionLen) / 2
+	rightPad := totalWidth - captionLen - leftPad
+
+	var segments rich.Segments
+	if leftPad > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", leftPad)})
+	}
+
+	segments = append(segments, rich.Segment{
+		Text:  caption,
+		Style: t.captionStyle,
+	})
+
+	if rightPad > 0 {
+		segments = append(segments, rich.Segment{Text: strings.Repeat(" ", rightPad)})
+	}
+
+	return segments
+}
+
 // renderHeader renders the header row.
-func (t *Table) renderHeader(widths []int) rich.Segments {
+func (t *Table) renderHeader(console *rich.Console, widths []int) rich.Segments {
 	var segments rich.Segments
 
 	if t.showEdge {
@@ -485,11 +1321,12 @@ func (t *Table) renderHeader(widths []int) rich.Segments {
 
 		// Left padding
 		segments = append(segments, rich.Segment{
-			Text: strings.Repeat(" ", t.padding),
+			Text: strings.Repeat(" ", t.padLeft),
 		})
 
-		// Header text (aligned)
-		text := t.alignText(col.Header, width, col.Align)
+		// Header text (aligned and truncated if needed)
+		header := rich.Truncate(col.Header, width, "")
+		text := t.alignText(header, width, col.effectiveAlign(console))
 		segments = append(segments, rich.Segment{
 			Text:  text,
 			Style: col.HeaderStyle,
@@ -497,14 +1334,14 @@ func (t *Table) renderHeader(widths []int) rich.Segments {
 
 		// Right padding
 		segments = append(segments, rich.Segment{
-			Text: strings.Repeat(" ", t.padding),
+			Text: strings.Repeat(" ", t.padRight),
 		})
 
 		// Column separator
 		if i < len(t.columns)-1 {
 			segments = append(segments, rich.Segment{
-				Text:  t.box.Left,
-				Style: t.borderStyle,
+				Text:  t.columnSeparator(),
+				Style: t.innerBorderStyleOrDefault(),
 			})
 		}
 	}
@@ -519,10 +1356,14 @@ func (t *Table) renderHeader(widths []int) rich.Segments {
 	return segments
 }
 
-// renderRow renders a data row.
-func (t *Table) renderRow(row []string, widths []int) rich.Segments {
+// renderRow renders the data row at index rowIndex.
+// Each cell's style is built up from its column's CellStyle, the row style
+// chosen by StyleFunc, and the cell's own style, in that order.
+func (t *Table) renderRow(console *rich.Console, rowIndex int, row []Cell, widths []int) rich.Segments {
 	var segments rich.Segments
 
+	rowStyle, styled := t.rowStyle(rowIndex, row)
+
 	if t.showEdge {
 		segments = append(segments, rich.Segment{
 			Text:  t.box.Left,
@@ -534,36 +1375,40 @@ func (t *Table) renderRow(row []string, widths []int) rich.Segments {
 		col := t.columns[i]
 		width := widths[i]
 
-		cellText := ""
-		if i < len(row) {
-			cellText = row[i]
+		cellText, placeholder := t.cellText(row, i)
+		cellStyle := col.CellStyle
+		if styled {
+			cellStyle = cellStyle.Combine(rowStyle)
+		}
+		if placeholder {
+			cellStyle = cellStyle.Dim()
+		} else if i < len(row) {
+			cellStyle = cellStyle.Combine(row[i].Style)
 		}
 
 		// Left padding
 		segments = append(segments, rich.Segment{
-			Text: strings.Repeat(" ", t.padding),
+			Text: strings.Repeat(" ", t.padLeft),
 		})
 
 		// Cell text (aligned and truncated if needed)
-		if len(cellText) > width {
-			cellText = cellText[:width]
-		}
-		text := t.alignText(cellText, width, col.Align)
+		cellText = rich.Truncate(cellText, width, "")
+		text := t.alignText(cellText, width, col.effectiveAlign(console))
 		segments = append(segments, rich.Segment{
 			Text:  text,
-			Style: col.CellStyle,
+			Style: cellStyle,
 		})
 
 		// Right padding
 		segments = append(segments, rich.Segment{
-			Text: strings.Repeat(" ", t.padding),
+			Text: strings.Repeat(" ", t.padRight),
 		})
 
 		// Column separator
 		if i < len(t.columns)-1 {
 			segments = append(segments, rich.Segment{
-				Text:  t.box.Left,
-				Style: t.borderStyle,
+				Text:  t.columnSeparator(),
+				Style: t.innerBorderStyleOrDefault(),
 			})
 		}
 	}
@@ -578,6 +1423,55 @@ func (t *Table) renderRow(row []string, widths []int) rich.Segments {
 	return segments
 }
 
+// renderEmptyRow renders the placeholder row shown when the table has no
+// rows. The NoRowsText message is centered across all columns, in dim style.
+func (t *Table) renderEmptyRow(widths []int) rich.Segments {
+	var segments rich.Segments
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Left,
+			Style: t.borderStyle,
+		})
+	}
+
+	width := t.spanWidth(widths, 0, len(widths))
+	text := rich.Truncate(t.noRowsText, width, "")
+
+	segments = append(segments, rich.Segment{
+		Text: strings.Repeat(" ", t.padLeft),
+	})
+	segments = append(segments, rich.Segment{
+		Text:  t.alignText(text, width, AlignCenter),
+		Style: rich.NewStyle().Dim(),
+	})
+	segments = append(segments, rich.Segment{
+		Text: strings.Repeat(" ", t.padRight),
+	})
+
+	if t.showEdge {
+		segments = append(segments, rich.Segment{
+			Text:  t.box.Right,
+			Style: t.borderStyle,
+		})
+	}
+
+	return segments
+}
+
+// cellText returns the text to display for column i of row.
+// Empty and missing cells are replaced by the EmptyText placeholder, if set,
+// in which case placeholder is true.
+func (t *Table) cellText(row []Cell, i int) (text string, placeholder bool) {
+	if i < len(row) {
+		text = row[i].Text
+	}
+	if text == "" && t.emptyText != "" {
+		return t.emptyText, true
+	}
+	return text, false
+}
+
 // alignText aligns text within a given width.
 // Adds padding spaces to position the text according to the alignment setting.
 //
@@ -588,12 +1482,12 @@ func (t *Table) renderRow(row []string, widths []int) rich.Segments {
 //   - AlignCenter: (leftPad) + text + (rightPad), where leftPad = padding/2
 //
 // The text parameter is the content to align.
-// The width parameter is the target width in characters.
+// The width parameter is the target width in display columns.
 // The align parameter specifies the alignment strategy.
 //
 // Returns the text padded to exactly the specified width.
 func (t *Table) alignText(text string, width int, align Align) string {
-	textLen := len(text)
+	textLen := rich.DisplayWidth(text)
 
 	// Text already fills or exceeds the width
 	if textLen >= width {
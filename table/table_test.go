@@ -1,6 +1,8 @@
 package table
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -52,6 +54,8 @@ func TestTableBoxStyles(t *testing.T) {
 		BoxDouble,
 		BoxHeavy,
 		BoxSimple,
+		BoxDashed,
+		BoxThickEdge,
 	}
 
 	for _, box := range boxes {
@@ -201,7 +205,7 @@ func TestTableChaining(t *testing.T) {
 	if table.showEdge {
 		t.Error("ShowEdge should be false")
 	}
-	if table.padding != 2 {
+	if table.padLeft != 2 || table.padRight != 2 {
 		t.Error("Padding not set")
 	}
 }
@@ -233,3 +237,866 @@ func TestAlignText(t *testing.T) {
 		}
 	}
 }
+
+func TestColumnDirection(t *testing.T) {
+	console := rich.NewConsole(nil)
+	console.SetColorMode(rich.ColorModeNone)
+
+	tbl := New().
+		ShowHeader(false).
+		AddColumn(NewColumn("Name").WithWidth(6).WithDirection(true)).
+		Row("abc")
+
+	lines := strings.Split(tbl.Render(console, 80).String(), "\n")
+	if lines[1] != "│    abc │" {
+		t.Errorf("RTL column should pad on the left, got %q", lines[1])
+	}
+
+	// Console default applies to columns without an explicit direction
+	console.SetRTL(true)
+	tbl = New().ShowHeader(false).AddColumn(NewColumn("Name").WithWidth(6)).Row("abc")
+	lines = strings.Split(tbl.Render(console, 80).String(), "\n")
+	if lines[1] != "│    abc │" {
+		t.Errorf("Console RTL default should pad on the left, got %q", lines[1])
+	}
+
+	// An explicit LTR column overrides the console default
+	tbl = New().ShowHeader(false).AddColumn(NewColumn("Name").WithWidth(6).WithDirection(false)).Row("abc")
+	lines = strings.Split(tbl.Render(console, 80).String(), "\n")
+	if lines[1] != "│ abc    │" {
+		t.Errorf("LTR column should pad on the right, got %q", lines[1])
+	}
+}
+
+func TestTableSuperHeaders(t *testing.T) {
+	table := New().
+		Box(BoxASCII).
+		SuperHeaders([]SpanCell{
+			NewSpanCell("Person", 2),
+			NewSpanCell("Location", 2),
+		}).
+		Headers("First", "Last", "City", "Country").
+		Row("Ada", "Lovelace", "London", "UK")
+
+	console := rich.NewConsole(nil)
+	lines := strings.Split(table.Render(console, 80).String(), "\n")
+
+	expected := []string{
+		"+------------------+------------------+",
+		"|      Person      |     Location     |",
+		"+-------+----------+--------+---------+",
+		"| First | Last     | City   | Country |",
+	}
+	for i, want := range expected {
+		if lines[i] != want {
+			t.Errorf("Line %d: expected '%s', got '%s'", i, want, lines[i])
+		}
+	}
+
+	// Span text wider than its columns widens the last spanned column
+	table = New().
+		Box(BoxASCII).
+		SuperHeaders([]SpanCell{NewSpanCell("Measurements", 2)}).
+		Headers("A", "B", "C")
+	lines = strings.Split(table.Render(console, 80).String(), "\n")
+
+	if lines[1] != "| Measurements |   |" {
+		t.Errorf("Expected '| Measurements |   |', got '%s'", lines[1])
+	}
+	if lines[3] != "| A | B        | C |" {
+		t.Errorf("Expected '| A | B        | C |', got '%s'", lines[3])
+	}
+}
+
+func TestTableInnerBorderStyle(t *testing.T) {
+	outer := rich.NewStyle().Foreground(rich.Blue)
+	inner := rich.NewStyle().Foreground(rich.Red)
+
+	table := New().
+		Headers("A", "B").
+		Row("1", "2").
+		BorderStyle(outer).
+		InnerBorderStyle(inner)
+
+	console := rich.NewConsole(nil)
+	console.SetColorMode(rich.ColorModeStandard)
+	segments := table.Render(console, 80)
+
+	for _, seg := range segments {
+		switch seg.Text {
+		case "┬", "┼", "┴":
+			if seg.Style.FgColor() != rich.Red {
+				t.Errorf("Expected junction '%s' to use the inner style", seg.Text)
+			}
+		case "┌", "┐", "└", "┘", "├", "┤":
+			if seg.Style.FgColor() != rich.Blue {
+				t.Errorf("Expected corner '%s' to use the outer style", seg.Text)
+			}
+		}
+	}
+
+	// Each of the header and data rows has two outer edges and one inner separator
+	var outerEdges, innerSeps int
+	for _, seg := range segments {
+		if seg.Text != "│" {
+			continue
+		}
+		switch seg.Style.FgColor() {
+		case rich.Blue:
+			outerEdges++
+		case rich.Red:
+			innerSeps++
+		}
+	}
+	if outerEdges != 4 || innerSeps != 2 {
+		t.Errorf("Expected 4 outer edges and 2 inner separators, got %d and %d", outerEdges, innerSeps)
+	}
+
+	// The ANSI output carries both colors
+	ansi := segments.ToANSI(rich.ColorModeStandard)
+	if !strings.Contains(ansi, "\x1b[34m│") || !strings.Contains(ansi, "\x1b[31m│") {
+		t.Errorf("Expected blue outer and red inner separators in %q", ansi)
+	}
+}
+
+func TestTableMeasure(t *testing.T) {
+	table := New().
+		Headers("Name", "Description").
+		Row("Alice", "likes long walks").
+		Row("Bob", "short")
+
+	console := rich.NewConsole(nil)
+	m := table.Measure(console, 80)
+
+	// Content: max(4, 5) + max(11, 16) = 21
+	// Structure: 2 columns × 2 padding + 1 separator + 2 edges = 7
+	if m.Maximum != 28 {
+		t.Errorf("Expected Maximum=28, got %d", m.Maximum)
+	}
+
+	// Narrowest columns: "Alice" (5) and "Description" (11)
+	if m.Minimum != 23 {
+		t.Errorf("Expected Minimum=23, got %d", m.Minimum)
+	}
+
+	// The maximum matches the rendered width
+	lines := strings.Split(table.Render(console, 80).String(), "\n")
+	if n := len([]rune(lines[0])); n != m.Maximum {
+		t.Errorf("Expected rendered width %d, got %d", m.Maximum, n)
+	}
+
+	// Without edges, the two border columns are not counted
+	if m := table.ShowEdge(false).Measure(console, 80); m.Maximum != 26 {
+		t.Errorf("Expected Maximum=26 without edges, got %d", m.Maximum)
+	}
+}
+
+func TestTableEmptyText(t *testing.T) {
+	table := New().
+		Headers("Name", "Email", "Phone").
+		EmptyText("N/A").
+		Row("Alice", "", "555-0100").
+		Row("Bob")
+
+	console := rich.NewConsole(nil)
+	segments := table.Render(console, 80)
+
+	var placeholders int
+	for _, seg := range segments {
+		if strings.TrimSpace(seg.Text) == "N/A" {
+			placeholders++
+			if !seg.Style.IsDim() {
+				t.Errorf("Expected placeholder to be dim")
+			}
+		}
+	}
+
+	// One empty cell in the first row, two missing cells in the second
+	if placeholders != 3 {
+		t.Errorf("Expected 3 placeholders, got %d", placeholders)
+	}
+
+	// Real content is not dimmed
+	for _, seg := range segments {
+		if strings.TrimSpace(seg.Text) == "Bob" && seg.Style.IsDim() {
+			t.Errorf("Expected cell content not to be dim")
+		}
+	}
+}
+
+func TestTableNoRowsText(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := New().Headers("Time", "Severity", "Message")
+	plain := strings.Split(tbl.Render(console, 80).String(), "\n")
+
+	// Without a placeholder: top border, header, separator, bottom border
+	if len(plain) != 4 {
+		t.Fatalf("Expected 4 lines without placeholder, got %d: %q", len(plain), plain)
+	}
+
+	// EmptyText only affects cells, not a table without rows
+	if lines := strings.Split(tbl.EmptyText("—").Render(console, 80).String(), "\n"); len(lines) != 4 {
+		t.Fatalf("Expected EmptyText not to add a row, got %q", lines)
+	}
+
+	tbl.NoRowsText("No data")
+	segments := tbl.Render(console, 80)
+	lines := strings.Split(segments.String(), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 lines with placeholder, got %d: %q", len(lines), lines)
+	}
+
+	// The placeholder row spans all columns, centered, with no separators
+	row := lines[3]
+	if rich.DisplayWidth(row) != rich.DisplayWidth(lines[0]) {
+		t.Errorf("Expected placeholder row width %d, got %d", rich.DisplayWidth(lines[0]), rich.DisplayWidth(row))
+	}
+	inner := strings.TrimSuffix(strings.TrimPrefix(row, "│"), "│")
+	if strings.Contains(inner, "│") {
+		t.Errorf("Expected placeholder row to span all columns, got %q", row)
+	}
+	left := len(inner) - len(strings.TrimLeft(inner, " "))
+	right := len(inner) - len(strings.TrimRight(inner, " "))
+	if strings.TrimSpace(inner) != "No data" || left-right > 1 || right-left > 1 {
+		t.Errorf("Expected centered placeholder, got %q", row)
+	}
+
+	for _, seg := range segments {
+		if strings.TrimSpace(seg.Text) == "No data" && !seg.Style.IsDim() {
+			t.Errorf("Expected placeholder to be dim")
+		}
+	}
+
+	// Once rows are added, the placeholder row disappears, and blank cells
+	// get the EmptyText placeholder rather than the message
+	tbl.Row("12:00", "info")
+	out := tbl.Render(console, 80).String()
+	if strings.Contains(out, "No data") {
+		t.Errorf("Did not expect placeholder with rows, got %q", out)
+	}
+	if !strings.Contains(out, "—") {
+		t.Errorf("Expected the EmptyText placeholder in the blank cell, got %q", out)
+	}
+}
+
+func TestTableCaption(t *testing.T) {
+	console := rich.NewConsole(nil)
+	captionStyle := rich.NewStyle().Italic().Foreground(rich.Yellow)
+
+	tbl := New().
+		Headers("Name", "Age").
+		Row("Alice", "30").
+		Caption("Showing 1 of 20").
+		CaptionStyle(captionStyle)
+
+	segments := tbl.Render(console, 80)
+	lines := strings.Split(segments.String(), "\n")
+
+	// The caption is the last line, directly after the bottom border
+	if !strings.HasPrefix(lines[len(lines)-2], "└") {
+		t.Errorf("Expected bottom border before caption, got %q", lines[len(lines)-2])
+	}
+	caption := lines[len(lines)-1]
+	if strings.TrimSpace(caption) != "Showing 1 of 20" {
+		t.Errorf("Expected caption line, got %q", caption)
+	}
+	if rich.DisplayWidth(caption) != rich.DisplayWidth(lines[0]) {
+		t.Errorf("Expected caption width %d, got %d", rich.DisplayWidth(lines[0]), rich.DisplayWidth(caption))
+	}
+	left := len(caption) - len(strings.TrimLeft(caption, " "))
+	right := len(caption) - len(strings.TrimRight(caption, " "))
+	if left-right > 1 || right-left > 1 {
+		t.Errorf("Expected centered caption, got %q", caption)
+	}
+
+	var found bool
+	for _, seg := range segments {
+		if seg.Text == "Showing 1 of 20" {
+			found = true
+			if !seg.Style.Equal(captionStyle) {
+				t.Errorf("Caption style = %s, want %s", seg.Style, captionStyle)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected a caption segment")
+	}
+}
+
+func TestTableCalculateWidths(t *testing.T) {
+	// Natural widths 2, 7, 42; minimum widths 2, 7, 11
+	newTable := func() *Table {
+		return New().
+			Headers("ID", "Status", "Description").
+			Row("1", "running", "Rebuild the search index for all customers").
+			Row("2", "queued", "Rotate keys")
+	}
+
+	// Expanding skips columns with a MaxWidth
+	capped := newTable().Expand(true)
+	capped.columns[2].WithMaxWidth(30)
+
+	tests := []struct {
+		name   string
+		table  *Table
+		width  int
+		widths []int
+	}{
+		// Structure: 3 columns * 2 padding + 2 separators + 2 edges = 10
+		{"wide", newTable(), 120, []int{2, 7, 42}},
+		{"exact", newTable(), 61, []int{2, 7, 42}},
+		{"narrow", newTable(), 40, []int{2, 7, 21}},
+		{"too narrow", newTable(), 20, []int{2, 7, 11}},
+		{"unlimited", newTable(), 0, []int{2, 7, 42}},
+		{"expand", newTable().Expand(true), 120, []int{4, 15, 91}},
+		{"expand narrow", newTable().Expand(true), 40, []int{2, 7, 21}},
+		{"expand max width", capped, 120, []int{17, 63, 30}},
+		{"fixed", newTable().AddColumn(NewColumn("Owner").WithWidth(8)), 50, []int{2, 7, 20, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.table.calculateWidths(tt.width)
+			if fmt.Sprint(got) != fmt.Sprint(tt.widths) {
+				t.Errorf("calculateWidths(%d) = %v, want %v", tt.width, got, tt.widths)
+			}
+		})
+	}
+
+	// Narrow columns get their maximum; the rest is shared by the wide ones
+	tbl := New().
+		Headers("A", "Long one", "Long two").
+		Row("x", strings.Repeat("word ", 10), strings.Repeat("word ", 10))
+	if got := tbl.calculateWidths(60); fmt.Sprint(got) != "[1 24 25]" {
+		t.Errorf("calculateWidths(60) = %v, want [1 24 25]", got)
+	}
+
+	// Rendered lines fit the width they were given
+	console := rich.NewConsole(nil)
+	for _, line := range strings.Split(newTable().Render(console, 40).String(), "\n") {
+		if w := rich.DisplayWidth(line); w != 40 {
+			t.Errorf("Expected line width 40, got %d: %q", w, line)
+		}
+	}
+}
+
+func TestTableBoxNoneSpacing(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	table := New().
+		Box(BoxNone).
+		Padding(0).
+		Headers("Name", "Age").
+		Row("Bob", "25").
+		Row("Alice", "7")
+
+	got := table.Render(console, 80).String()
+	want := "Name  Age\n" +
+		"Bob   25 \n" +
+		"Alice 7  "
+	if got != want {
+		t.Errorf("BoxNone output:\n%q\nwant:\n%q", got, want)
+	}
+
+	// Measurement agrees with the rendered width
+	if m := table.Measure(console, 80); m.Maximum != 9 {
+		t.Errorf("Expected Maximum=9, got %d", m.Maximum)
+	}
+
+	// With default padding, columns are separated by padding and a space
+	got = New().Box(BoxNone).Headers("A", "B").Row("1", "2").Render(console, 80).String()
+	want = " A   B \n" +
+		" 1   2 "
+	if got != want {
+		t.Errorf("BoxNone padded output:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestTableBoxDashed(t *testing.T) {
+	got := New().
+		Box(BoxDashed).
+		ShowRowLines(true).
+		Headers("Name", "Age").
+		Row("Bob", "25").
+		Row("Alice", "7").
+		Render(rich.NewConsole(nil), 80).String()
+
+	want := "┌╌╌╌╌╌╌╌┬╌╌╌╌╌┐\n" +
+		"╎ Name  ╎ Age ╎\n" +
+		"├╌╌╌╌╌╌╌┼╌╌╌╌╌┤\n" +
+		"╎ Bob   ╎ 25  ╎\n" +
+		"├╌╌╌╌╌╌╌┼╌╌╌╌╌┤\n" +
+		"╎ Alice ╎ 7   ╎\n" +
+		"└╌╌╌╌╌╌╌┴╌╌╌╌╌┘"
+	if got != want {
+		t.Errorf("BoxDashed output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTableBoxThickEdge(t *testing.T) {
+	got := New().
+		Box(BoxThickEdge).
+		ShowRowLines(true).
+		Headers("Name", "Age").
+		Row("Bob", "25").
+		Row("Alice", "7").
+		Render(rich.NewConsole(nil), 80).String()
+
+	want := "┏━━━━━━━┯━━━━━┓\n" +
+		"┃ Name  │ Age ┃\n" +
+		"┠───────┼─────┨\n" +
+		"┃ Bob   │ 25  ┃\n" +
+		"┠───────┼─────┨\n" +
+		"┃ Alice │ 7   ┃\n" +
+		"┗━━━━━━━┷━━━━━┛"
+	if got != want {
+		t.Errorf("BoxThickEdge output:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Clearing the column separator falls back to the outer edge character
+	box := BoxThickEdge.WithColumnSeparator("")
+	lines := strings.Split(New().Box(box).Headers("A", "B").Render(rich.NewConsole(nil), 80).String(), "\n")
+	if lines[1] != "┃ A ┃ B ┃" {
+		t.Errorf("Expected '┃ A ┃ B ┃', got '%s'", lines[1])
+	}
+}
+
+func TestTableTitleTopBorder(t *testing.T) {
+	table := New().
+		Box(BoxASCII).
+		Title("T").
+		Headers("A", "B")
+
+	lines := strings.Split(table.Render(rich.NewConsole(nil), 80).String(), "\n")
+
+	// No column separator sits directly below the top border, so no junction
+	if lines[0] != "+-------+" {
+		t.Errorf("Expected '+-------+', got '%s'", lines[0])
+	}
+}
+
+func TestBoxValidate(t *testing.T) {
+	for _, box := range []Box{BoxASCII, BoxRounded, BoxDouble, BoxHeavy, BoxSimple, BoxDashed, BoxThickEdge, BoxNone, NewBox()} {
+		if err := box.Validate(); err != nil {
+			t.Errorf("Expected predefined box to be valid, got %v", err)
+		}
+	}
+
+	box := Box{}.
+		WithCorners("+", "+", "+", "+").
+		WithEdges("-", "|").
+		WithJunctions("+", "", "+", "+", "+")
+
+	err := box.Validate()
+	if err == nil {
+		t.Fatal("Expected an error for a partially specified box")
+	}
+
+	want := "table: box is missing MidBottom, HeaderRow, HeaderLeft, HeaderRight"
+	if err.Error() != want {
+		t.Errorf("Expected '%s', got '%s'", want, err.Error())
+	}
+
+	box = box.WithJunctions("+", "+", "+", "+", "+").WithHeaderSeparator("=", "+", "+")
+	if err := box.Validate(); err != nil {
+		t.Errorf("Expected completed box to be valid, got %v", err)
+	}
+}
+
+func TestTableShowRowLines(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := New().
+		Headers("A", "B").
+		Row("1", "2").
+		Row("3", "4").
+		ShowRowLines(true)
+
+	lines := strings.Split(tbl.Render(console, 40).String(), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("Expected 7 lines, got %d: %q", len(lines), lines)
+	}
+	if lines[4] != "├───┼───┤" {
+		t.Errorf("Row line = %q, want %q", lines[4], "├───┼───┤")
+	}
+
+	// Without edges, lines end flush with the cells
+	tbl.ShowEdge(false)
+	lines = strings.Split(tbl.Render(console, 40).String(), "\n")
+
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 lines, got %d: %q", len(lines), lines)
+	}
+	if lines[3] != "───┼───" {
+		t.Errorf("Row line = %q, want %q", lines[3], "───┼───")
+	}
+	for i, line := range lines {
+		for _, corner := range []string{"┌", "┐", "└", "┘", "├", "┤", "┬", "┴", "│ ", " │"} {
+			if strings.HasPrefix(line, corner) || strings.HasSuffix(line, corner) {
+				t.Errorf("Line %d %q has stray %q at its end", i, line, corner)
+			}
+		}
+	}
+}
+
+func TestTableRenderStream(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := New().Headers("ID", "Name")
+	for i := 0; i < 50; i++ {
+		tbl.Row(strings.Repeat("x", i%5+1), "row")
+	}
+
+	var chunks []rich.Segments
+	tbl.RenderStream(console, 40, func(chunk rich.Segments) {
+		chunks = append(chunks, chunk)
+	})
+
+	// Top border, header, separator, 50 rows, bottom border
+	if len(chunks) != 54 {
+		t.Errorf("Expected 54 chunks (at least one per row), got %d", len(chunks))
+	}
+
+	var streamed rich.Segments
+	for _, chunk := range chunks {
+		streamed = append(streamed, chunk...)
+	}
+	if streamed.String() != tbl.Render(console, 40).String() {
+		t.Error("Streamed output does not match Render output")
+	}
+	if got := strings.Count(streamed.String(), "│ row  │"); got != 50 {
+		t.Errorf("Expected all 50 rows in output, got %d", got)
+	}
+}
+
+func TestTablePaddingSides(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tbl := New().
+		Headers("A", "B").
+		Row("1", "2").
+		PaddingSides(1, 2, 0, 3)
+
+	lines := strings.Split(tbl.Render(console, 40).String(), "\n")
+
+	want := []string{
+		"┌──────┬──────┐",
+		"│      │      │",
+		"│   A  │   B  │",
+		"├──────┼──────┤",
+		"│      │      │",
+		"│   1  │   2  │",
+		"└──────┴──────┘",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+
+	// Padding is a shortcut for horizontal padding only
+	tbl.Padding(1)
+	lines = strings.Split(tbl.Render(console, 40).String(), "\n")
+	if len(lines) != 5 || lines[3] != "│ 1 │ 2 │" {
+		t.Errorf("Expected single-line rows after Padding(1), got %q", lines)
+	}
+}
+
+func TestTableMeasureMatchesRender(t *testing.T) {
+	console := rich.NewConsole(nil)
+
+	tables := []*Table{
+		New().Headers("A", "B").Row("one", "two"),
+		New().Headers("Name", "Description").Row("x", "a longer cell").ShowEdge(false),
+		New().Headers("A", "B").Row("1", "2").Box(BoxNone),
+		New().Headers("A").Row("1").PaddingSides(0, 3, 0, 1),
+	}
+
+	for i, tbl := range tables {
+		m := tbl.Measure(console, 80)
+		for j, line := range strings.Split(tbl.Render(console, 80).String(), "\n") {
+			if w := rich.DisplayWidth(line); w != m.Maximum {
+				t.Errorf("table %d line %d %q is %d wide, Measure().Maximum = %d", i, j, line, w, m.Maximum)
+			}
+		}
+	}
+}
+
+func TestTableExportText(t *testing.T) {
+	build := func() *Table {
+		return New().
+			Title("Users").
+			TitleStyle(rich.NewStyle().Bold().Foreground(rich.Cyan)).
+			BorderStyle(rich.NewStyle().Foreground(rich.Blue)).
+			Headers("Name", "Role").
+			Row("Alice", "Admin").
+			Row("Bob", "Viewer")
+	}
+
+	colored := rich.NewConsole(nil)
+	colored.SetColorMode(rich.ColorModeTrueColor)
+	colored.SetWidth(40)
+
+	plain := rich.NewConsole(nil)
+	plain.SetColorMode(rich.ColorModeNone)
+	plain.SetWidth(40)
+
+	got := colored.ExportText(build())
+	want := plain.RenderToString(build())
+	if got != want {
+		t.Errorf("ExportText() =\n%s\nwant\n%s", got, want)
+	}
+	if strings.Contains(got, "\x1b[") {
+		t.Errorf("Expected no escape codes, got %q", got)
+	}
+
+	// The console keeps its color mode for normal rendering
+	if !strings.Contains(colored.RenderToString(build()), "\x1b[") {
+		t.Error("Expected the colored console to still emit escape codes")
+	}
+}
+
+func TestTableEmojiAlignment(t *testing.T) {
+	tbl := New().
+		Headers("Service", "Status", "Uptime").
+		Row("API Server", "🟢 Running", "99.9%").
+		Row("Cache", "⚠️ Degraded", "97.2%").
+		Row("Edge", "🇺🇸 us-east", "99.5%")
+
+	lines := strings.Split(tbl.Render(rich.NewConsole(nil), 80).String(), "\n")
+	want := rich.DisplayWidth(lines[0])
+	for i, line := range lines {
+		if w := rich.DisplayWidth(line); w != want {
+			t.Errorf("line %d %q is %d columns wide, want %d", i, line, w, want)
+		}
+	}
+}
+
+func TestTableStyledRow(t *testing.T) {
+	red := rich.NewStyle().Bold().Foreground(rich.Red)
+	tbl := New().
+		AddColumn(NewColumn("Job")).
+		AddColumn(NewColumn("Status").WithCellStyle(rich.NewStyle().Italic())).
+		Row("build", "ok").
+		StyledRow(Cell{Text: "deploy"}, Cell{Text: "FAILED", Style: red})
+
+	segments := tbl.Render(rich.NewConsole(nil), 80)
+
+	styleOf := func(prefix string) rich.Style {
+		for _, seg := range segments {
+			if strings.HasPrefix(seg.Text, prefix) {
+				return seg.Style
+			}
+		}
+		t.Fatalf("No segment starting with %q", prefix)
+		return rich.Style{}
+	}
+
+	// The styled cell is layered over the column style
+	if got, want := styleOf("FAILED"), rich.NewStyle().Italic().Bold().Foreground(rich.Red); !got.Equal(want) {
+		t.Errorf("FAILED style = %v, want %v", got, want)
+	}
+
+	// Its siblings keep their column styles
+	if got := styleOf("deploy"); !got.Equal(rich.NewStyle()) {
+		t.Errorf("deploy style = %v, want none", got)
+	}
+	if got := styleOf("ok"); !got.Equal(rich.NewStyle().Italic()) {
+		t.Errorf("ok style = %v, want italic", got)
+	}
+
+	// Styled rows lay out like plain ones
+	lines := strings.Split(segments.String(), "\n")
+	if !strings.Contains(lines[4], "deploy") || !strings.Contains(lines[4], "FAILED") {
+		t.Errorf("Expected the styled row on line 4, got %q", lines)
+	}
+}
+
+func TestTableStyleFunc(t *testing.T) {
+	red := rich.NewStyle().Foreground(rich.Red)
+	var indexes []int
+	tbl := New().
+		AddColumn(NewColumn("Job")).
+		AddColumn(NewColumn("Status").WithCellStyle(rich.NewStyle().Italic())).
+		Row("build", "OK").
+		Row("deploy", "ERROR").
+		Row("test").
+		StyledRow(Cell{Text: "lint"}, Cell{Text: "ERROR", Style: rich.NewStyle().Bold()}).
+		ShowRowNumbers(true).
+		StyleFunc(func(rowIndex int, cells []string) (rich.Style, bool) {
+			indexes = append(indexes, rowIndex)
+			if len(cells) != 2 {
+				t.Errorf("Expected 2 cells, got %q", cells)
+			}
+			return red, cells[1] == "ERROR"
+		})
+
+	segments := tbl.Render(rich.NewConsole(nil), 80)
+
+	styleOf := func(prefix string) rich.Style {
+		for _, seg := range segments {
+			if strings.HasPrefix(seg.Text, prefix) {
+				return seg.Style
+			}
+		}
+		t.Fatalf("No segment starting with %q", prefix)
+		return rich.Style{}
+	}
+
+	if fmt.Sprint(indexes) != "[0 1 2 3]" {
+		t.Errorf("Expected StyleFunc called for rows [0 1 2 3], got %v", indexes)
+	}
+
+	// Matching rows get the style, layered over the column styles
+	if got := styleOf("deploy"); !got.Equal(red) {
+		t.Errorf("deploy style = %v, want %v", got, red)
+	}
+	if got, want := styleOf("ERROR"), rich.NewStyle().Italic().Foreground(rich.Red); !got.Equal(want) {
+		t.Errorf("ERROR style = %v, want %v", got, want)
+	}
+	if got := styleOf("2"); !got.Equal(red) {
+		t.Errorf("row number style = %v, want %v", got, red)
+	}
+
+	// A cell's own style is layered over the row style
+	var lintStatus rich.Style
+	for _, seg := range segments {
+		if strings.HasPrefix(seg.Text, "ERROR") {
+			lintStatus = seg.Style
+		}
+	}
+	if want := rich.NewStyle().Italic().Bold().Foreground(rich.Red); !lintStatus.Equal(want) {
+		t.Errorf("lint status style = %v, want %v", lintStatus, want)
+	}
+
+	// Other rows are untouched
+	if got := styleOf("build"); !got.Equal(rich.NewStyle()) {
+		t.Errorf("build style = %v, want none", got)
+	}
+	if got := styleOf("OK"); !got.Equal(rich.NewStyle().Italic()) {
+		t.Errorf("OK style = %v, want italic", got)
+	}
+	if got := styleOf("test"); !got.Equal(rich.NewStyle()) {
+		t.Errorf("test style = %v, want none", got)
+	}
+}
+
+func TestTableSortByColumn(t *testing.T) {
+	firstColumn := func(tbl *Table) []string {
+		var got []string
+		for _, row := range tbl.rows {
+			got = append(got, row[0].Text)
+		}
+		return got
+	}
+	build := func() *Table {
+		return New().
+			Headers("Name", "Count").
+			Row("delta", "10").
+			Row("alpha", "9").
+			Row("charlie", "n/a").
+			Row("bravo", "100").
+			Row("echo", " 9.5 ")
+	}
+
+	tests := []struct {
+		name    string
+		column  int
+		numeric bool
+		want    []string
+	}{
+		{"lexical names", 0, false, []string{"alpha", "bravo", "charlie", "delta", "echo"}},
+		{"numeric counts", 1, true, []string{"alpha", "echo", "delta", "bravo", "charlie"}},
+		{"lexical counts", 1, false, []string{"echo", "delta", "bravo", "alpha", "charlie"}},
+		{"out of range", 5, false, []string{"delta", "alpha", "charlie", "bravo", "echo"}},
+	}
+
+	for _, tt := range tests {
+		tbl := build().SortByColumn(tt.column, tt.numeric)
+		if got := firstColumn(tbl); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s: order = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Custom comparison, e.g. descending; the rendered output follows the new order
+	tbl := build().SortBy(0, func(a, b string) bool { return a > b })
+	out := tbl.Render(rich.NewConsole(nil), 80).String()
+	if strings.Index(out, "echo") > strings.Index(out, "alpha") {
+		t.Errorf("Expected echo to render before alpha, got:\n%s", out)
+	}
+}
+
+func TestTableShowRowNumbers(t *testing.T) {
+	tbl := New().Headers("Task").ShowRowNumbers(true)
+	for i := 0; i < 10; i++ {
+		tbl.Row("step")
+	}
+
+	console := rich.NewConsole(nil)
+	lines := strings.Split(tbl.Render(console, 80).String(), "\n")
+
+	// Header row: right-aligned "#" in a column wide enough for "10"
+	if !strings.HasPrefix(lines[1], "│  # │ Task") {
+		t.Errorf("Expected '#' header, got %q", lines[1])
+	}
+	for i := 1; i <= 10; i++ {
+		line := lines[2+i]
+		want := "│ " + strings.Repeat(" ", 2-len(strconv.Itoa(i))) + strconv.Itoa(i) + " │ step"
+		if !strings.HasPrefix(line, want) {
+			t.Errorf("Row %d = %q, want prefix %q", i, line, want)
+		}
+	}
+
+	// The column is synthetic: the table's own columns and rows are unchanged
+	if len(tbl.columns) != 1 || len(tbl.rows[0]) != 1 {
+		t.Errorf("Expected row numbers not to modify the table, got %d columns", len(tbl.columns))
+	}
+
+	// Measurement includes the extra column
+	if m := tbl.Measure(console, 80); m.Maximum != rich.DisplayWidth(lines[0]) {
+		t.Errorf("Measure().Maximum = %d, want %d", m.Maximum, rich.DisplayWidth(lines[0]))
+	}
+}
+
+func TestTableReverseColumns(t *testing.T) {
+	var styled []string
+	tbl := New().
+		Box(BoxASCII).
+		SuperHeaders([]SpanCell{NewSpanCell("Id", 2)}).
+		Headers("Name", "Value", "Unit").
+		Row("cpu", "93", "%").
+		Row("mem").
+		ShowRowNumbers(true).
+		StyleFunc(func(rowIndex int, cells []string) (rich.Style, bool) {
+			styled = append(styled, cells[0])
+			return rich.Style{}, false
+		}).
+		ReverseColumns(true)
+
+	got := tbl.Render(rich.NewConsole(nil), 80).String()
+	want := "+---+------+--------------+\n" +
+		"|   |      |      Id      |\n" +
+		"+---+------+-------+------+\n" +
+		"| # | Unit | Value | Name |\n" +
+		"+---+------+-------+------+\n" +
+		"| 1 | %    | 93    | cpu  |\n" +
+		"| 2 |      |       | mem  |\n" +
+		"+---+------+-------+------+"
+	if got != want {
+		t.Errorf("Reversed output:\n%s\nwant:\n%s", got, want)
+	}
+
+	// StyleFunc still sees the cells in the original order
+	if fmt.Sprint(styled) != "[cpu mem]" {
+		t.Errorf("Expected StyleFunc to see names first, got %v", styled)
+	}
+
+	// Only the rendering is reversed
+	if tbl.columns[0].Header != "Name" || tbl.rows[0][0].Text != "cpu" {
+		t.Errorf("Expected ReverseColumns not to modify the table")
+	}
+}
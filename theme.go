@@ -0,0 +1,105 @@
+package rich
+
+import "fmt"
+
+// Theme holds the styles and prefix glyphs a Console uses for status messages
+// printed with Error, Warn, Info, and Success.
+//
+// Start from DefaultTheme and override the fields you want to change:
+//
+//	theme := rich.DefaultTheme()
+//	theme.ErrorPrefix = "error: "
+//	theme.InfoStyle = rich.NewStyle().Foreground(rich.Magenta)
+//	console.SetTheme(theme)
+type Theme struct {
+	ErrorStyle   Style // Style for Error messages
+	WarnStyle    Style // Style for Warn messages
+	InfoStyle    Style // Style for Info messages
+	SuccessStyle Style // Style for Success messages
+
+	ErrorPrefix   string // Text printed before Error messages (may be empty)
+	WarnPrefix    string // Text printed before Warn messages (may be empty)
+	InfoPrefix    string // Text printed before Info messages (may be empty)
+	SuccessPrefix string // Text printed before Success messages (may be empty)
+}
+
+// DefaultTheme returns the theme used by new consoles:
+//   - Error: bold red, prefixed with "✗ "
+//   - Warn: yellow, prefixed with "⚠ "
+//   - Info: cyan, prefixed with "ℹ "
+//   - Success: green, prefixed with "✓ "
+func DefaultTheme() Theme {
+	return Theme{
+		ErrorStyle:   NewStyle().Foreground(Red).Bold(),
+		WarnStyle:    NewStyle().Foreground(Yellow),
+		InfoStyle:    NewStyle().Foreground(Cyan),
+		SuccessStyle: NewStyle().Foreground(Green),
+
+		ErrorPrefix:   "✗ ",
+		WarnPrefix:    "⚠ ",
+		InfoPrefix:    "ℹ ",
+		SuccessPrefix: "✓ ",
+	}
+}
+
+// SetTheme sets the theme used by Error, Warn, Info, and Success.
+//
+// Example:
+//
+//	theme := rich.DefaultTheme()
+//	theme.WarnPrefix = "WARNING: "
+//	console.SetTheme(theme)
+func (c *Console) SetTheme(theme Theme) {
+	c.theme = theme
+}
+
+// Theme returns the console's current theme.
+func (c *Console) Theme() Theme {
+	return c.theme
+}
+
+// Error prints an error message using the theme's error style and prefix,
+// followed by a newline. Arguments are formatted as with fmt.Sprint.
+//
+// Example:
+//
+//	console.Error("failed to open ", path) // ✗ failed to open config.yaml
+func (c *Console) Error(a ...interface{}) (n int, err error) {
+	return c.printThemed(c.theme.ErrorPrefix, c.theme.ErrorStyle, a)
+}
+
+// Warn prints a warning message using the theme's warning style and prefix,
+// followed by a newline. Arguments are formatted as with fmt.Sprint.
+//
+// Example:
+//
+//	console.Warn("disk usage above 90%") // ⚠ disk usage above 90%
+func (c *Console) Warn(a ...interface{}) (n int, err error) {
+	return c.printThemed(c.theme.WarnPrefix, c.theme.WarnStyle, a)
+}
+
+// Info prints an informational message using the theme's info style and prefix,
+// followed by a newline. Arguments are formatted as with fmt.Sprint.
+//
+// Example:
+//
+//	console.Info("using cached results") // ℹ using cached results
+func (c *Console) Info(a ...interface{}) (n int, err error) {
+	return c.printThemed(c.theme.InfoPrefix, c.theme.InfoStyle, a)
+}
+
+// Success prints a success message using the theme's success style and prefix,
+// followed by a newline. Arguments are formatted as with fmt.Sprint.
+//
+// Example:
+//
+//	console.Success("deployed ", version) // ✓ deployed v1.2.3
+func (c *Console) Success(a ...interface{}) (n int, err error) {
+	return c.printThemed(c.theme.SuccessPrefix, c.theme.SuccessStyle, a)
+}
+
+// printThemed prints prefix and the formatted message as a single styled line.
+func (c *Console) printThemed(prefix string, style Style, a []interface{}) (n int, err error) {
+	text := prefix + fmt.Sprint(a...)
+	return c.PrintSegmentsln(Segments{{Text: text, Style: style}})
+}
@@ -0,0 +1,47 @@
+package rich
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestConsoleThemedMessages(t *testing.T) {
+	var buf bytes.Buffer
+	console := NewConsole(&buf)
+	console.SetColorMode(ColorModeStandard)
+
+	console.Error("x")
+
+	theme := DefaultTheme()
+	want := theme.ErrorStyle.toANSI(ColorModeStandard) + "✗ x" + "\x1b[0m\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Error output = %q, want %q", got, want)
+	}
+
+	// Custom theme styles and prefixes are honored
+	theme.WarnStyle = NewStyle().Foreground(Magenta)
+	theme.WarnPrefix = "WARNING: "
+	console.SetTheme(theme)
+	console.SetColorMode(ColorModeNone)
+
+	tests := []struct {
+		print func(a ...interface{}) (int, error)
+		want  string
+	}{
+		{console.Warn, "WARNING: low disk\n"},
+		{console.Info, "ℹ low disk\n"},
+		{console.Success, "✓ low disk\n"},
+	}
+
+	for _, tt := range tests {
+		buf.Reset()
+		tt.print("low ", "disk")
+		if got := buf.String(); got != tt.want {
+			t.Errorf("Output = %q, want %q", got, tt.want)
+		}
+	}
+
+	if console.Theme().WarnPrefix != "WARNING: " {
+		t.Errorf("Expected Theme() to return the custom theme")
+	}
+}
@@ -0,0 +1,220 @@
+package rich
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// DisplayWidth returns the number of terminal columns needed to display s.
+// Unlike len (bytes) or utf8.RuneCountInString (runes), it accounts for
+// characters that occupy two cells (CJK ideographs, fullwidth forms, emoji)
+// and characters that occupy none (combining marks, zero-width joiners,
+// variation selectors, control characters).
+//
+// Emoji sequences are measured as a whole, the way terminals draw them:
+//   - a character followed by VS16 (U+FE0F) takes emoji presentation and is
+//     two cells wide, so "⚠️" is 2 even though "⚠" alone is 1
+//   - characters joined by ZWJ (U+200D), such as "👨‍👩‍👧", share one
+//     two-cell glyph
+//   - skin tone modifiers add no width to the emoji they follow
+//   - a pair of regional indicators is a single two-cell flag
+//
+// The string should not contain ANSI escape sequences; strip them first.
+//
+// Example:
+//
+//	rich.DisplayWidth("hello") // 5
+//	rich.DisplayWidth("日本")   // 4
+//	rich.DisplayWidth("é")     // 1 (e + combining acute accent)
+//	rich.DisplayWidth("⚠️")     // 2 (warning sign + VS16)
+func DisplayWidth(s string) int {
+	width := 0
+	for s != "" {
+		size, w := nextCluster(s)
+		width += w
+		s = s[size:]
+	}
+	return width
+}
+
+// nextCluster returns the length in bytes and the display width of the first
+// character cluster in s: a base character together with the zero-width
+// characters that modify it (combining marks, variation selectors), emoji
+// modifiers, characters joined to it with ZWJ, and the second half of a flag.
+// Controls such as newline and tab are always clusters of their own.
+func nextCluster(s string) (size, width int) {
+	r, size := utf8.DecodeRuneInString(s)
+	width = RuneWidth(r)
+	if r < 0x20 || r == 0x7F {
+		return size, width
+	}
+
+	// Two regional indicators form a flag
+	if isRegionalIndicator(r) {
+		if next, n := utf8.DecodeRuneInString(s[size:]); isRegionalIndicator(next) {
+			return size + n, 2
+		}
+		return size, width
+	}
+
+	joined := false // The previous rune was a zero-width joiner
+	for size < len(s) {
+		next, n := utf8.DecodeRuneInString(s[size:])
+		switch {
+		case next == 0xFE0F:
+			// VS16 requests emoji presentation, which is two cells wide
+			if width == 1 {
+				width = 2
+			}
+		case next == 0x200D:
+			joined = width == 2
+		case joined:
+			// Joined to an emoji: drawn as part of the same glyph
+			joined = false
+		case isEmojiModifier(next) && width == 2:
+			// Skin tones recolor the emoji they follow
+		case next >= 0x20 && isZeroWidth(next):
+			// Combining marks and other zero-width characters
+		default:
+			return size, width
+		}
+		size += n
+	}
+	return size, width
+}
+
+// isRegionalIndicator reports whether r is one of the letters used in pairs
+// to spell flag emoji (U+1F1E6-U+1F1FF).
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// isEmojiModifier reports whether r is a skin tone modifier (U+1F3FB-U+1F3FF).
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+// RuneWidth returns the number of terminal columns needed to display r:
+// 0 for zero-width characters, 2 for wide characters, and 1 otherwise.
+// It looks at a single rune; use DisplayWidth for text that may contain
+// emoji sequences, whose width depends on the runes around them.
+func RuneWidth(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		// C0/C1 control characters
+		return 0
+	case r < 0x300:
+		// Fast path for Latin text
+		return 1
+	case isZeroWidth(r):
+		return 0
+	case isWide(r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// isZeroWidth reports whether r is rendered without advancing the cursor.
+func isZeroWidth(r rune) bool {
+	switch {
+	case r == 0x200B || r == 0x200C || r == 0x200D || r == 0x2060 || r == 0xFEFF:
+		// Zero-width space, non-joiner, joiner, word joiner, BOM
+		return true
+	case r >= 0xFE00 && r <= 0xFE0F:
+		// Variation selectors
+		return true
+	case r >= 0xE0100 && r <= 0xE01EF:
+		// Variation selectors supplement
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me)
+}
+
+// wideRanges lists code point ranges that terminals render two cells wide.
+// Based on the East Asian Wide (W) and Fullwidth (F) categories, plus the
+// emoji blocks that terminals display with emoji presentation.
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo initial consonants
+	{0x231A, 0x231B},   // Watch, hourglass
+	{0x2329, 0x232A},   // Angle brackets
+	{0x23E9, 0x23EC},   // Media control symbols
+	{0x23F0, 0x23F0},   // Alarm clock
+	{0x23F3, 0x23F3},   // Hourglass with flowing sand
+	{0x25FD, 0x25FE},   // Medium small squares
+	{0x2614, 0x2615},   // Umbrella, hot beverage
+	{0x2648, 0x2653},   // Zodiac signs
+	{0x267F, 0x267F},   // Wheelchair symbol
+	{0x2693, 0x2693},   // Anchor
+	{0x26A1, 0x26A1},   // High voltage
+	{0x26AA, 0x26AB},   // Medium circles
+	{0x26BD, 0x26BE},   // Soccer ball, baseball
+	{0x26C4, 0x26C5},   // Snowman, sun behind cloud
+	{0x26CE, 0x26CE},   // Ophiuchus
+	{0x26D4, 0x26D4},   // No entry
+	{0x26EA, 0x26EA},   // Church
+	{0x26F2, 0x26F3},   // Fountain, golf
+	{0x26F5, 0x26F5},   // Sailboat
+	{0x26FA, 0x26FA},   // Tent
+	{0x26FD, 0x26FD},   // Fuel pump
+	{0x2705, 0x2705},   // Check mark button
+	{0x270A, 0x270B},   // Raised fist, raised hand
+	{0x2728, 0x2728},   // Sparkles
+	{0x274C, 0x274C},   // Cross mark
+	{0x274E, 0x274E},   // Cross mark button
+	{0x2753, 0x2755},   // Question and exclamation marks
+	{0x2757, 0x2757},   // Heavy exclamation mark
+	{0x2795, 0x2797},   // Heavy plus, minus, division
+	{0x27B0, 0x27B0},   // Curly loop
+	{0x27BF, 0x27BF},   // Double curly loop
+	{0x2B1B, 0x2B1C},   // Large squares
+	{0x2B50, 0x2B50},   // Star
+	{0x2B55, 0x2B55},   // Heavy large circle
+	{0x2E80, 0x303E},   // CJK radicals, punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, CJK compatibility
+	{0x3400, 0x4DBF},   // CJK Unified Ideographs Extension A
+	{0x4E00, 0x9FFF},   // CJK Unified Ideographs
+	{0xA000, 0xA4CF},   // Yi syllables and radicals
+	{0xA960, 0xA97F},   // Hangul Jamo Extended-A
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE10, 0xFE19},   // Vertical forms
+	{0xFE30, 0xFE6F},   // CJK compatibility forms, small form variants
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x16FE0, 0x16FE4}, // Ideographic symbols
+	{0x17000, 0x18CFF}, // Tangut
+	{0x1B000, 0x1B2FF}, // Kana supplement and extensions
+	{0x1F004, 0x1F004}, // Mahjong tile red dragon
+	{0x1F0CF, 0x1F0CF}, // Playing card black joker
+	{0x1F18E, 0x1F18E}, // Negative squared AB
+	{0x1F191, 0x1F19A}, // Squared CL..VS
+	{0x1F200, 0x1F2FF}, // Enclosed ideographic supplement
+	{0x1F300, 0x1F64F}, // Misc symbols and pictographs, emoticons
+	{0x1F680, 0x1F6FF}, // Transport and map symbols
+	{0x1F7E0, 0x1F7EB}, // Large colored circles and squares
+	{0x1F900, 0x1F9FF}, // Supplemental symbols and pictographs
+	{0x1FA70, 0x1FAFF}, // Symbols and pictographs extended-A
+	{0x20000, 0x2FFFD}, // CJK Unified Ideographs Extensions B-F
+	{0x30000, 0x3FFFD}, // CJK Unified Ideographs Extension G+
+}
+
+// isWide reports whether r is rendered two cells wide.
+func isWide(r rune) bool {
+	// Binary search over the sorted ranges
+	lo, hi := 0, len(wideRanges)-1
+	for lo <= hi {
+		mid := (lo + hi) / 2
+		switch {
+		case r < wideRanges[mid][0]:
+			hi = mid - 1
+		case r > wideRanges[mid][1]:
+			lo = mid + 1
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package rich
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"café", 4},
+		{"é", 1}, // e + combining acute accent
+		{"日本語", 6},
+		{"한글", 4},
+		{"ＡＢ", 4},   // Fullwidth Latin
+		{"a\tb", 2}, // Control characters are zero width
+		{"🚀", 2},
+		{"☰", 1},
+	}
+
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.input); got != tt.want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestWideRangesSorted(t *testing.T) {
+	for i, r := range wideRanges {
+		if r[0] > r[1] {
+			t.Errorf("Range %d is inverted: %X-%X", i, r[0], r[1])
+		}
+		if i > 0 && r[0] <= wideRanges[i-1][1] {
+			t.Errorf("Range %d (%X) overlaps or precedes range %d (%X)", i, r[0], i-1, wideRanges[i-1][1])
+		}
+	}
+}
+
+func TestDisplayWidthEmoji(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"plain emoji", "🟢", 2},
+		{"plain emoji with text", "🟢 Running", 10},
+		{"VS16 emoji", "⚠️", 2},
+		{"text presentation", "⚠", 1},
+		{"VS15 stays narrow", "⚠︎", 1},
+		{"VS16 heart", "❤️", 2},
+		{"keycap", "1️⃣", 2},
+		{"flag", "🇺🇸", 2},
+		{"two flags", "🇺🇸🇯🇵", 4},
+		{"lone regional indicator", "🇺", 1},
+		{"ZWJ family", "👨‍👩‍👧", 2},
+		{"ZWJ with VS16", "🏳️‍🌈", 2},
+		{"skin tone", "👍🏽", 2},
+		{"combining mark", "é", 1},
+		{"bare variation selector", "️", 0},
+	}
+
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.input); got != tt.want {
+			t.Errorf("%s: DisplayWidth(%q) = %d, want %d", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestTruncateEmojiSequences(t *testing.T) {
+	// Sequences are never split, so the cut lands before them
+	if got := Truncate("ab👨‍👩‍👧cd", 3, ""); got != "ab" {
+		t.Errorf("Truncate() = %q, want %q", got, "ab")
+	}
+	if got := Truncate("ab👨‍👩‍👧cd", 4, ""); got != "ab👨‍👩‍👧" {
+		t.Errorf("Truncate() = %q, want %q", got, "ab👨‍👩‍👧")
+	}
+	if got := Truncate("⚠️ warning", 2, ""); got != "⚠️" {
+		t.Errorf("Truncate() = %q, want %q", got, "⚠️")
+	}
+}
@@ -0,0 +1,178 @@
+package rich
+
+// Truncate shortens s to at most width display columns.
+// If s is cut, the ellipsis is appended and its width is reserved, so the
+// result never exceeds width. Strings that already fit are returned unchanged.
+//
+// Truncation is wide-character aware: a two-column character that would
+// straddle the limit is dropped rather than split, so the result may be one
+// column narrower than width. Combining marks stay with their base character.
+//
+// If the ellipsis itself is wider than width, s is cut to width without one.
+//
+// Example:
+//
+//	rich.Truncate("Hello, World", 8, "…")  // "Hello, …"
+//	rich.Truncate("日本語テキスト", 7, "...") // "日本..."
+//	rich.Truncate("short", 10, "…")        // "short"
+func Truncate(s string, width int, ellipsis string) string {
+	if width <= 0 {
+		return ""
+	}
+	if DisplayWidth(s) <= width {
+		return s
+	}
+
+	available := width - DisplayWidth(ellipsis)
+	if available < 0 {
+		return cutToWidth(s, width)
+	}
+	return cutToWidth(s, available) + ellipsis
+}
+
+// cutToWidth returns the longest prefix of s that fits in width columns.
+// Emoji sequences and characters with combining marks are never split.
+func cutToWidth(s string, width int) string {
+	end, used := 0, 0
+	for end < len(s) {
+		size, w := nextCluster(s[end:])
+		if used+w > width {
+			break
+		}
+		end += size
+		used += w
+	}
+	return s[:end]
+}
+
+// Wrap word-wraps the segments into lines no wider than width display
+// columns. Existing newlines always start a new line. Lines are broken at
+// spaces, which are dropped at the break; words longer than width are split
+// across lines. Each piece keeps the style of the segment it came from, so
+// styled runs may span several output lines.
+//
+// Leading spaces at the start of a paragraph are kept (for indentation);
+// runs of spaces between words are kept as long as the next word fits.
+// A width less than 1 is treated as 1.
+//
+// Example:
+//
+//	segments := Segments{{Text: "The quick brown fox", Style: NewStyle().Bold()}}
+//	lines := segments.Wrap(10)
+//	// lines[0].String() == "The quick"
+//	// lines[1].String() == "brown fox"
+func (s Segments) Wrap(width int) []Segments {
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []Segments
+	for _, paragraph := range s.SplitLines() {
+		lines = append(lines, wrapLine(paragraph, width)...)
+	}
+	return lines
+}
+
+// wrapToken is a run of either spaces or non-space characters, possibly
+// spanning several differently styled segments.
+type wrapToken struct {
+	parts Segments // Styled pieces of the run
+	width int      // Display width of the run
+	space bool     // Whether the run is whitespace
+}
+
+// tokenizeLine splits a single line into alternating word and space tokens.
+func tokenizeLine(line Segments) []wrapToken {
+	var tokens []wrapToken
+
+	for _, seg := range line {
+		for i := 0; i < len(seg.Text); {
+			size, width := nextCluster(seg.Text[i:])
+			char := seg.Text[i : i+size]
+			i += size
+
+			space := char[0] == ' ' || char[0] == '\t'
+			if len(tokens) == 0 || tokens[len(tokens)-1].space != space {
+				tokens = append(tokens, wrapToken{space: space})
+			}
+
+			tok := &tokens[len(tokens)-1]
+			if n := len(tok.parts); n > 0 && tok.parts[n-1].Style.Equal(seg.Style) {
+				tok.parts[n-1].Text += char
+			} else {
+				tok.parts = append(tok.parts, Segment{Text: char, Style: seg.Style})
+			}
+			tok.width += width
+		}
+	}
+
+	return tokens
+}
+
+// wrapLine greedily wraps a single line (without newlines) to width.
+func wrapLine(line Segments, width int) []Segments {
+	var lines []Segments
+	var current Segments
+	var pending Segments // Spaces seen since the last word
+	used, pendingWidth := 0, 0
+
+	flush := func() {
+		lines = append(lines, current)
+		current, pending = nil, nil
+		used, pendingWidth = 0, 0
+	}
+
+	for _, tok := range tokenizeLine(line) {
+		if tok.space {
+			pending = append(pending, tok.parts...)
+			pendingWidth += tok.width
+			continue
+		}
+
+		if used+pendingWidth+tok.width <= width {
+			// The word fits on the current line, with the spaces before it
+			current = append(current, pending...)
+			current = append(current, tok.parts...)
+			used += pendingWidth + tok.width
+			pending, pendingWidth = nil, 0
+			continue
+		}
+
+		// Start a new line, dropping the spaces at the break
+		if used > 0 {
+			flush()
+		}
+		pending, pendingWidth = nil, 0
+
+		// Split words that are too long for a line of their own
+		for _, part := range tok.parts {
+			text := part.Text
+			for text != "" {
+				if used == width {
+					flush()
+				}
+				piece := cutToWidth(text, width-used)
+				if piece == "" {
+					if used == 0 {
+						// A character wider than the whole line: emit it alone
+						size, _ := nextCluster(text)
+						piece = text[:size]
+					} else {
+						flush()
+						continue
+					}
+				}
+				current = append(current, Segment{Text: piece, Style: part.Style})
+				used += DisplayWidth(piece)
+				text = text[len(piece):]
+			}
+		}
+	}
+
+	// Trailing spaces are kept if they fit, so blank padding survives
+	if used+pendingWidth <= width {
+		current = append(current, pending...)
+	}
+
+	return append(lines, current)
+}
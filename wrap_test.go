@@ -0,0 +1,90 @@
+package rich
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{"ascii", "Hello, World", 8, "…", "Hello, …"},
+		{"ascii multi-char ellipsis", "Hello, World", 8, "...", "Hello..."},
+		{"exact fit", "Hello", 5, "…", "Hello"},
+		{"shorter than width", "Hi", 10, "…", "Hi"},
+		{"empty ellipsis", "Hello, World", 5, "", "Hello"},
+		{"wide chars", "日本語テキスト", 7, "...", "日本..."},
+		{"wide char boundary", "日本語テキスト", 6, "…", "日本…"},
+		{"wide exact fit", "日本語", 6, "…", "日本語"},
+		{"combining mark kept", "éèà", 2, "…", "é…"},
+		{"ellipsis wider than width", "Hello", 2, "...", "He"},
+		{"zero width", "Hello", 0, "…", ""},
+	}
+
+	for _, tt := range tests {
+		got := Truncate(tt.input, tt.width, tt.ellipsis)
+		if got != tt.want {
+			t.Errorf("%s: Truncate(%q, %d, %q) = %q, want %q", tt.name, tt.input, tt.width, tt.ellipsis, got, tt.want)
+		}
+		if w := DisplayWidth(got); w > tt.width {
+			t.Errorf("%s: result %q is %d columns, exceeds %d", tt.name, got, w, tt.width)
+		}
+	}
+}
+
+func TestSegmentsWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  []string
+	}{
+		{"fits", "Hello world", 20, []string{"Hello world"}},
+		{"word wrap", "The quick brown fox", 10, []string{"The quick", "brown fox"}},
+		{"exact width", "abc def", 3, []string{"abc", "def"}},
+		{"long word split", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"long word after text", "ab cdefghij", 4, []string{"ab", "cdef", "ghij"}},
+		{"newlines kept", "one\n\ntwo", 10, []string{"one", "", "two"}},
+		{"leading indent", "  indented text", 10, []string{"  indented", "text"}},
+		{"wide chars", "日本語 テキスト", 6, []string{"日本語", "テキス", "ト"}},
+	}
+
+	for _, tt := range tests {
+		lines := Segments{{Text: tt.input}}.Wrap(tt.width)
+		var got []string
+		for _, line := range lines {
+			got = append(got, line.String())
+		}
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("%s: Wrap(%q, %d) = %q, want %q", tt.name, tt.input, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestSegmentsWrapKeepsStyles(t *testing.T) {
+	bold := NewStyle().Bold()
+	segments := Segments{
+		{Text: "plain ", Style: NewStyle()},
+		{Text: "bold words", Style: bold},
+	}
+
+	lines := segments.Wrap(11)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if lines[0].String() != "plain bold" || lines[1].String() != "words" {
+		t.Errorf("Unexpected lines %q, %q", lines[0].String(), lines[1].String())
+	}
+	last := lines[0][len(lines[0])-1]
+	if last.Text != "bold" || !last.Style.Equal(bold) {
+		t.Errorf("Expected bold piece on first line, got %+v", last)
+	}
+	if !lines[1][0].Style.Equal(bold) {
+		t.Errorf("Expected wrapped piece to stay bold, got %+v", lines[1][0])
+	}
+}